@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.0
+// source: gametracker/game_abandoned.proto
+
+package gametrackermsgpb
+
+import (
+	gametracker "github.com/emortalmc/proto-specs/gen/go/model/gametracker"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GameAbandonedMessage_Reason int32
+
+const (
+	// TIMED_OUT the game was not updated within the timeout of its game mode
+	GameAbandonedMessage_TIMED_OUT GameAbandonedMessage_Reason = 0
+	// SERVER_GONE the game server of the game no longer exists
+	GameAbandonedMessage_SERVER_GONE GameAbandonedMessage_Reason = 1
+)
+
+// Enum value maps for GameAbandonedMessage_Reason.
+var (
+	GameAbandonedMessage_Reason_name = map[int32]string{
+		0: "TIMED_OUT",
+		1: "SERVER_GONE",
+	}
+	GameAbandonedMessage_Reason_value = map[string]int32{
+		"TIMED_OUT":   0,
+		"SERVER_GONE": 1,
+	}
+)
+
+func (x GameAbandonedMessage_Reason) Enum() *GameAbandonedMessage_Reason {
+	p := new(GameAbandonedMessage_Reason)
+	*p = x
+	return p
+}
+
+func (x GameAbandonedMessage_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameAbandonedMessage_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_gametracker_game_abandoned_proto_enumTypes[0].Descriptor()
+}
+
+func (GameAbandonedMessage_Reason) Type() protoreflect.EnumType {
+	return &file_gametracker_game_abandoned_proto_enumTypes[0]
+}
+
+func (x GameAbandonedMessage_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameAbandonedMessage_Reason.Descriptor instead.
+func (GameAbandonedMessage_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_gametracker_game_abandoned_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// GameAbandonedMessage is sent when a live game is moved to the historic games without receiving a finish message.
+// The game has no winner data.
+// It is shared by the game-tracker, which sends it, and the services that consume it.
+type GameAbandonedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId     string                         `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GameModeId string                         `protobuf:"bytes,2,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	ServerId   string                         `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Players    []*gametracker.BasicGamePlayer `protobuf:"bytes,4,rep,name=players,proto3" json:"players,omitempty"`
+	StartTime  *timestamppb.Timestamp         `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	// last_updated is when the last message of the game was received, which is also used as the end time
+	LastUpdated *timestamppb.Timestamp      `protobuf:"bytes,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Reason      GameAbandonedMessage_Reason `protobuf:"varint,7,opt,name=reason,proto3,enum=emortal.gametracker.message.GameAbandonedMessage_Reason" json:"reason,omitempty"`
+}
+
+func (x *GameAbandonedMessage) Reset() {
+	*x = GameAbandonedMessage{}
+	mi := &file_gametracker_game_abandoned_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameAbandonedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameAbandonedMessage) ProtoMessage() {}
+
+func (x *GameAbandonedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_game_abandoned_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameAbandonedMessage.ProtoReflect.Descriptor instead.
+func (*GameAbandonedMessage) Descriptor() ([]byte, []int) {
+	return file_gametracker_game_abandoned_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GameAbandonedMessage) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameAbandonedMessage) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *GameAbandonedMessage) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *GameAbandonedMessage) GetPlayers() []*gametracker.BasicGamePlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameAbandonedMessage) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GameAbandonedMessage) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *GameAbandonedMessage) GetReason() GameAbandonedMessage_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return GameAbandonedMessage_TIMED_OUT
+}
+
+var File_gametracker_game_abandoned_proto protoreflect.FileDescriptor
+
+var file_gametracker_game_abandoned_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x61, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x1b, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x03, 0x0a, 0x14,
+	0x47, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63,
+	0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x50, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x38, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x61, 0x6e, 0x64, 0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0d,
+	0x0a, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x47, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x92, 0x01,
+	0x0a, 0x23, 0x64, 0x65, 0x76, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x19, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x62, 0x61, 0x6e, 0x64,
+	0x6f, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x6d, 0x63, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x67,
+	0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x6d, 0x73, 0x67,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gametracker_game_abandoned_proto_rawDescOnce sync.Once
+	file_gametracker_game_abandoned_proto_rawDescData = file_gametracker_game_abandoned_proto_rawDesc
+)
+
+func file_gametracker_game_abandoned_proto_rawDescGZIP() []byte {
+	file_gametracker_game_abandoned_proto_rawDescOnce.Do(func() {
+		file_gametracker_game_abandoned_proto_rawDescData = protoimpl.X.CompressGZIP(file_gametracker_game_abandoned_proto_rawDescData)
+	})
+	return file_gametracker_game_abandoned_proto_rawDescData
+}
+
+var file_gametracker_game_abandoned_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gametracker_game_abandoned_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_gametracker_game_abandoned_proto_goTypes = []any{
+	(GameAbandonedMessage_Reason)(0),    // 0: emortal.gametracker.message.GameAbandonedMessage.Reason
+	(*GameAbandonedMessage)(nil),        // 1: emortal.gametracker.message.GameAbandonedMessage
+	(*gametracker.BasicGamePlayer)(nil), // 2: emortal.model.game_tracker.BasicGamePlayer
+	(*timestamppb.Timestamp)(nil),       // 3: google.protobuf.Timestamp
+}
+var file_gametracker_game_abandoned_proto_depIdxs = []int32{
+	2, // 0: emortal.gametracker.message.GameAbandonedMessage.players:type_name -> emortal.model.game_tracker.BasicGamePlayer
+	3, // 1: emortal.gametracker.message.GameAbandonedMessage.start_time:type_name -> google.protobuf.Timestamp
+	3, // 2: emortal.gametracker.message.GameAbandonedMessage.last_updated:type_name -> google.protobuf.Timestamp
+	0, // 3: emortal.gametracker.message.GameAbandonedMessage.reason:type_name -> emortal.gametracker.message.GameAbandonedMessage.Reason
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_gametracker_game_abandoned_proto_init() }
+func file_gametracker_game_abandoned_proto_init() {
+	if File_gametracker_game_abandoned_proto != nil {
+		return
+	}
+	file_gametracker_game_abandoned_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gametracker_game_abandoned_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gametracker_game_abandoned_proto_goTypes,
+		DependencyIndexes: file_gametracker_game_abandoned_proto_depIdxs,
+		EnumInfos:         file_gametracker_game_abandoned_proto_enumTypes,
+		MessageInfos:      file_gametracker_game_abandoned_proto_msgTypes,
+	}.Build()
+	File_gametracker_game_abandoned_proto = out.File
+	file_gametracker_game_abandoned_proto_rawDesc = nil
+	file_gametracker_game_abandoned_proto_goTypes = nil
+	file_gametracker_game_abandoned_proto_depIdxs = nil
+}
@@ -0,0 +1,8 @@
+module github.com/emortalmc/mono-services/libraries/gametracker
+
+go 1.23
+
+require (
+	github.com/emortalmc/proto-specs/gen/go v0.0.0-20240927103241-2584fd28e0f9
+	google.golang.org/protobuf v1.35.1
+)
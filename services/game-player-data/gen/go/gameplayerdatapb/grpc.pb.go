@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.0
+// source: gameplayerdata/grpc.proto
+
+package gameplayerdatapb
+
+import (
+	gameplayerdata "github.com/emortalmc/proto-specs/gen/go/model/gameplayerdata"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateGamePlayerDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string                          `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameMode gameplayerdata.GameDataGameMode `protobuf:"varint,2,opt,name=game_mode,json=gameMode,proto3,enum=emortal.model.gameplayerdata.GameDataGameMode" json:"game_mode,omitempty"`
+	// data is the game mode's player data, e.g. V1BlockSumoPlayerData
+	Data *anypb.Any `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// data_mask is the fields of data to set, it must not be empty unless data has no fields.
+	DataMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=data_mask,json=dataMask,proto3" json:"data_mask,omitempty"`
+}
+
+func (x *UpdateGamePlayerDataRequest) Reset() {
+	*x = UpdateGamePlayerDataRequest{}
+	mi := &file_gameplayerdata_grpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGamePlayerDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGamePlayerDataRequest) ProtoMessage() {}
+
+func (x *UpdateGamePlayerDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gameplayerdata_grpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGamePlayerDataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGamePlayerDataRequest) Descriptor() ([]byte, []int) {
+	return file_gameplayerdata_grpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateGamePlayerDataRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *UpdateGamePlayerDataRequest) GetGameMode() gameplayerdata.GameDataGameMode {
+	if x != nil {
+		return x.GameMode
+	}
+	return gameplayerdata.GameDataGameMode(0)
+}
+
+func (x *UpdateGamePlayerDataRequest) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UpdateGamePlayerDataRequest) GetDataMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.DataMask
+	}
+	return nil
+}
+
+type UpdateGamePlayerDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data is the player's data after the update
+	Data *anypb.Any `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UpdateGamePlayerDataResponse) Reset() {
+	*x = UpdateGamePlayerDataResponse{}
+	mi := &file_gameplayerdata_grpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGamePlayerDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGamePlayerDataResponse) ProtoMessage() {}
+
+func (x *UpdateGamePlayerDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gameplayerdata_grpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGamePlayerDataResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGamePlayerDataResponse) Descriptor() ([]byte, []int) {
+	return file_gameplayerdata_grpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateGamePlayerDataResponse) GetData() *anypb.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_gameplayerdata_grpc_proto protoreflect.FileDescriptor
+
+var file_gameplayerdata_grpc_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x67, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x22, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x1a,
+	0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x01, 0x0a, 0x1b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x65, 0x6d,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x67, 0x61, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x37, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x48, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x32, 0xb2, 0x01, 0x0a, 0x14, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x12, 0x99, 0x01, 0x0a, 0x14,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x9a, 0x01, 0x0a, 0x2a, 0x64, 0x65, 0x76, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x42, 0x16, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x54,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6d, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x6d, 0x63, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x67, 0x61, 0x6d, 0x65,
+	0x2d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2d, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x64, 0x61,
+	0x74, 0x61, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gameplayerdata_grpc_proto_rawDescOnce sync.Once
+	file_gameplayerdata_grpc_proto_rawDescData = file_gameplayerdata_grpc_proto_rawDesc
+)
+
+func file_gameplayerdata_grpc_proto_rawDescGZIP() []byte {
+	file_gameplayerdata_grpc_proto_rawDescOnce.Do(func() {
+		file_gameplayerdata_grpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_gameplayerdata_grpc_proto_rawDescData)
+	})
+	return file_gameplayerdata_grpc_proto_rawDescData
+}
+
+var file_gameplayerdata_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_gameplayerdata_grpc_proto_goTypes = []any{
+	(*UpdateGamePlayerDataRequest)(nil),  // 0: emortal.grpc.gameplayerdata.writer.UpdateGamePlayerDataRequest
+	(*UpdateGamePlayerDataResponse)(nil), // 1: emortal.grpc.gameplayerdata.writer.UpdateGamePlayerDataResponse
+	(gameplayerdata.GameDataGameMode)(0), // 2: emortal.model.gameplayerdata.GameDataGameMode
+	(*anypb.Any)(nil),                    // 3: google.protobuf.Any
+	(*fieldmaskpb.FieldMask)(nil),        // 4: google.protobuf.FieldMask
+}
+var file_gameplayerdata_grpc_proto_depIdxs = []int32{
+	2, // 0: emortal.grpc.gameplayerdata.writer.UpdateGamePlayerDataRequest.game_mode:type_name -> emortal.model.gameplayerdata.GameDataGameMode
+	3, // 1: emortal.grpc.gameplayerdata.writer.UpdateGamePlayerDataRequest.data:type_name -> google.protobuf.Any
+	4, // 2: emortal.grpc.gameplayerdata.writer.UpdateGamePlayerDataRequest.data_mask:type_name -> google.protobuf.FieldMask
+	3, // 3: emortal.grpc.gameplayerdata.writer.UpdateGamePlayerDataResponse.data:type_name -> google.protobuf.Any
+	0, // 4: emortal.grpc.gameplayerdata.writer.GamePlayerDataWriter.UpdateGamePlayerData:input_type -> emortal.grpc.gameplayerdata.writer.UpdateGamePlayerDataRequest
+	1, // 5: emortal.grpc.gameplayerdata.writer.GamePlayerDataWriter.UpdateGamePlayerData:output_type -> emortal.grpc.gameplayerdata.writer.UpdateGamePlayerDataResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_gameplayerdata_grpc_proto_init() }
+func file_gameplayerdata_grpc_proto_init() {
+	if File_gameplayerdata_grpc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gameplayerdata_grpc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gameplayerdata_grpc_proto_goTypes,
+		DependencyIndexes: file_gameplayerdata_grpc_proto_depIdxs,
+		MessageInfos:      file_gameplayerdata_grpc_proto_msgTypes,
+	}.Build()
+	File_gameplayerdata_grpc_proto = out.File
+	file_gameplayerdata_grpc_proto_rawDesc = nil
+	file_gameplayerdata_grpc_proto_goTypes = nil
+	file_gameplayerdata_grpc_proto_depIdxs = nil
+}
@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.28.0
+// source: gameplayerdata/grpc.proto
+
+package gameplayerdatapb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GamePlayerDataWriterClient is the client API for GamePlayerDataWriter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GamePlayerDataWriterClient interface {
+	// UpdateGamePlayerData applies the same update as an UpdateGamePlayerDataMessage, but synchronously.
+	// Returns INVALID_ARGUMENT if the data isn't the game mode's data type, or the mask is empty or contains an unknown
+	// field. The mask may be empty if the data type has no fields, e.g. V1TowerDefencePlayerData.
+	UpdateGamePlayerData(ctx context.Context, in *UpdateGamePlayerDataRequest, opts ...grpc.CallOption) (*UpdateGamePlayerDataResponse, error)
+}
+
+type gamePlayerDataWriterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGamePlayerDataWriterClient(cc grpc.ClientConnInterface) GamePlayerDataWriterClient {
+	return &gamePlayerDataWriterClient{cc}
+}
+
+func (c *gamePlayerDataWriterClient) UpdateGamePlayerData(ctx context.Context, in *UpdateGamePlayerDataRequest, opts ...grpc.CallOption) (*UpdateGamePlayerDataResponse, error) {
+	out := new(UpdateGamePlayerDataResponse)
+	err := c.cc.Invoke(ctx, "/emortal.grpc.gameplayerdata.writer.GamePlayerDataWriter/UpdateGamePlayerData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GamePlayerDataWriterServer is the server API for GamePlayerDataWriter service.
+// All implementations must embed UnimplementedGamePlayerDataWriterServer
+// for forward compatibility
+type GamePlayerDataWriterServer interface {
+	// UpdateGamePlayerData applies the same update as an UpdateGamePlayerDataMessage, but synchronously.
+	// Returns INVALID_ARGUMENT if the data isn't the game mode's data type, or the mask is empty or contains an unknown
+	// field. The mask may be empty if the data type has no fields, e.g. V1TowerDefencePlayerData.
+	UpdateGamePlayerData(context.Context, *UpdateGamePlayerDataRequest) (*UpdateGamePlayerDataResponse, error)
+	mustEmbedUnimplementedGamePlayerDataWriterServer()
+}
+
+// UnimplementedGamePlayerDataWriterServer must be embedded to have forward compatible implementations.
+type UnimplementedGamePlayerDataWriterServer struct {
+}
+
+func (UnimplementedGamePlayerDataWriterServer) UpdateGamePlayerData(context.Context, *UpdateGamePlayerDataRequest) (*UpdateGamePlayerDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGamePlayerData not implemented")
+}
+func (UnimplementedGamePlayerDataWriterServer) mustEmbedUnimplementedGamePlayerDataWriterServer() {}
+
+// UnsafeGamePlayerDataWriterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GamePlayerDataWriterServer will
+// result in compilation errors.
+type UnsafeGamePlayerDataWriterServer interface {
+	mustEmbedUnimplementedGamePlayerDataWriterServer()
+}
+
+func RegisterGamePlayerDataWriterServer(s grpc.ServiceRegistrar, srv GamePlayerDataWriterServer) {
+	s.RegisterService(&GamePlayerDataWriter_ServiceDesc, srv)
+}
+
+func _GamePlayerDataWriter_UpdateGamePlayerData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGamePlayerDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GamePlayerDataWriterServer).UpdateGamePlayerData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.grpc.gameplayerdata.writer.GamePlayerDataWriter/UpdateGamePlayerData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GamePlayerDataWriterServer).UpdateGamePlayerData(ctx, req.(*UpdateGamePlayerDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GamePlayerDataWriter_ServiceDesc is the grpc.ServiceDesc for GamePlayerDataWriter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GamePlayerDataWriter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.grpc.gameplayerdata.writer.GamePlayerDataWriter",
+	HandlerType: (*GamePlayerDataWriterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateGamePlayerData",
+			Handler:    _GamePlayerDataWriter_UpdateGamePlayerData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gameplayerdata/grpc.proto",
+}
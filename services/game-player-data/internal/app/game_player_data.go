@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"github.com/emortalmc/mono-services/services/game-player-data/internal/config"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/gamemode"
 	"github.com/emortalmc/mono-services/services/game-player-data/internal/kafka"
 	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository"
 	"github.com/emortalmc/mono-services/services/game-player-data/internal/service"
@@ -25,11 +26,11 @@ func Run(cfg *config.Config, logger *zap.SugaredLogger) {
 		logger.Fatalw("failed to create database", err)
 	}
 
-	repoColl := repository.NewGamePlayerDataRepoColl(mongoDB)
+	modes := gamemode.NewRegistry(mongoDB)
 
-	kafka.NewConsumer(ctx, wg, cfg.Kafka, logger, repoColl)
+	kafka.NewConsumer(ctx, wg, cfg.Kafka, logger, modes)
 
-	service.RunServices(ctx, logger, wg, cfg, repoColl)
+	service.RunServices(ctx, logger, wg, cfg, modes)
 
 	wg.Wait()
 	logger.Info("shutting down")
@@ -0,0 +1,137 @@
+package gamemode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository/model"
+	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/gameplayerdata"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var ErrInvalidUpdate = errors.New("invalid update")
+
+// maxUpdateAttempts is how often an update is tried if the data is changed concurrently
+const maxUpdateAttempts = 5
+
+type mode[P proto.Message, T model.ProtoGameData[P]] struct {
+	gameMode pbmodel.GameDataGameMode
+	repo     repository.GameDataRepository[T]
+
+	// newData creates the default data of a player without any stored data, which an update is applied to
+	newData func(playerId uuid.UUID) T
+}
+
+// register adds a game mode whose data is stored as T in collName and sent as P
+func register[P proto.Message, T model.ProtoGameData[P]](r *Registry, db *mongo.Database, gameMode pbmodel.GameDataGameMode,
+	collName string, newData func(playerId uuid.UUID) T) {
+
+	if _, ok := r.modes[gameMode]; ok {
+		panic(fmt.Sprintf("game mode %s registered twice", gameMode))
+	}
+
+	r.modes[gameMode] = &mode[P, T]{
+		gameMode: gameMode,
+		repo:     repository.NewMongoGameDataRepository[T](db, collName),
+		newData:  newData,
+	}
+}
+
+func (m *mode[P, T]) GameMode() pbmodel.GameDataGameMode {
+	return m.gameMode
+}
+
+func (m *mode[P, T]) Get(ctx context.Context, playerId uuid.UUID) (model.GameData, error) {
+	return m.repo.Get(ctx, playerId)
+}
+
+func (m *mode[P, T]) GetMultiple(ctx context.Context, playerIds []uuid.UUID) ([]model.GameData, error) {
+	data, err := m.repo.GetMultiple(ctx, playerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	generic := make([]model.GameData, len(data))
+	for i, d := range data {
+		generic[i] = d
+	}
+
+	return generic, nil
+}
+
+func (m *mode[P, T]) Update(ctx context.Context, playerId uuid.UUID, data *anypb.Any, mask *fieldmaskpb.FieldMask,
+	strict bool) (model.GameData, error) {
+
+	var zero P
+	update := zero.ProtoReflect().New().Interface()
+	if err := anypb.UnmarshalTo(data, update, proto.UnmarshalOptions{}); err != nil {
+		return nil, fmt.Errorf("%w: failed to unmarshal data: %w", ErrInvalidUpdate, err)
+	}
+
+	// An empty mask used to change nothing, so it is rejected rather than taken to mean every field.
+	// Data without fields, such as Tower Defence's, can only be sent with an empty mask, which creates the player's data.
+	hasFields := update.ProtoReflect().Descriptor().Fields().Len() > 0
+	if strict && hasFields && len(mask.GetPaths()) == 0 {
+		return nil, fmt.Errorf("%w: empty data mask", ErrInvalidUpdate)
+	}
+
+	// The whole document is replaced, so the update is retried on fresh data if another update saved in between.
+	// Otherwise concurrent updates of different fields would overwrite each other.
+	for attempt := 1; ; attempt++ {
+		gameData, err := m.repo.GetOrDefault(ctx, playerId, m.newData(playerId))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s data: %w", m.gameMode, err)
+		}
+
+		current := gameData.ToProto()
+		if err := applyFieldMask(current.ProtoReflect(), update.ProtoReflect(), mask.GetPaths(), strict); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidUpdate, err)
+		}
+		gameData.FromProto(current)
+
+		err = m.repo.Save(ctx, gameData)
+		if errors.Is(err, repository.ErrVersionConflict) && attempt < maxUpdateAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to save %s data: %w", m.gameMode, err)
+		}
+
+		return gameData, nil
+	}
+}
+
+// applyFieldMask copies the fields in paths from src to dst. Unknown paths are an error if strict, otherwise skipped.
+// Only top level fields are supported, as no game data has nested messages.
+func applyFieldMask(dst protoreflect.Message, src protoreflect.Message, paths []string, strict bool) error {
+	fields := dst.Descriptor().Fields()
+
+	for _, path := range paths {
+		field := fields.ByName(protoreflect.Name(path))
+		if field == nil && !strict {
+			continue
+		}
+		if field == nil {
+			return fmt.Errorf("unknown field %q in mask for %s", path, dst.Descriptor().FullName())
+		}
+
+		copyField(dst, src, field)
+	}
+
+	return nil
+}
+
+// copyField clears the field in dst if it isn't set in src, so a masked field can be reset to its default
+func copyField(dst protoreflect.Message, src protoreflect.Message, field protoreflect.FieldDescriptor) {
+	if src.Has(field) {
+		dst.Set(field, src.Get(field))
+	} else {
+		dst.Clear(field)
+	}
+}
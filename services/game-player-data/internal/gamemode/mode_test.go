@@ -0,0 +1,203 @@
+package gamemode
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository/model"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/utils"
+	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/gameplayerdata"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"testing"
+)
+
+func TestApplyFieldMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		dst     proto.Message
+		src     proto.Message
+		paths   []string
+		lenient bool
+		want    proto.Message
+		wantErr bool
+	}{
+		{
+			name:  "known path",
+			dst:   &pbmodel.V1BlockSumoPlayerData{BlockSlot: 1, ShearsSlot: 2},
+			src:   &pbmodel.V1BlockSumoPlayerData{BlockSlot: 5, ShearsSlot: 6},
+			paths: []string{"block_slot"},
+			want:  &pbmodel.V1BlockSumoPlayerData{BlockSlot: 5, ShearsSlot: 2},
+		},
+		{
+			name:    "unknown path",
+			dst:     &pbmodel.V1BlockSumoPlayerData{BlockSlot: 1},
+			src:     &pbmodel.V1BlockSumoPlayerData{BlockSlot: 5},
+			paths:   []string{"block_slot", "unknown"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown path skipped when lenient",
+			dst:     &pbmodel.V1BlockSumoPlayerData{BlockSlot: 1},
+			src:     &pbmodel.V1BlockSumoPlayerData{BlockSlot: 5},
+			paths:   []string{"block_slot", "unknown"},
+			lenient: true,
+			want:    &pbmodel.V1BlockSumoPlayerData{BlockSlot: 5},
+		},
+		{
+			name:  "unset field is cleared",
+			dst:   &pbmodel.V1MinesweeperPlayerData{Length: 10, Width: 10, Theme: "dark"},
+			src:   &pbmodel.V1MinesweeperPlayerData{Length: 20},
+			paths: []string{"length", "theme"},
+			want:  &pbmodel.V1MinesweeperPlayerData{Length: 20, Width: 10},
+		},
+		{
+			name:  "optional field is set",
+			dst:   &pbmodel.V1MarathonData{Time: "day"},
+			src:   &pbmodel.V1MarathonData{Animation: utils.PointerOf("bounce")},
+			paths: []string{"animation"},
+			want:  &pbmodel.V1MarathonData{Time: "day", Animation: utils.PointerOf("bounce")},
+		},
+		{
+			name:  "unset optional field is cleared",
+			dst:   &pbmodel.V1MarathonData{Time: "day", Animation: utils.PointerOf("bounce")},
+			src:   &pbmodel.V1MarathonData{},
+			paths: []string{"animation"},
+			want:  &pbmodel.V1MarathonData{Time: "day"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := applyFieldMask(tt.dst.ProtoReflect(), tt.src.ProtoReflect(), tt.paths, !tt.lenient)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(tt.want, tt.dst), "got %v, want %v", tt.dst, tt.want)
+		})
+	}
+}
+
+// conflictingRepo fails the first conflicts saves with ErrVersionConflict
+type conflictingRepo[T model.GameData] struct {
+	repository.GameDataRepository[T]
+
+	conflicts int
+	gets      int
+	saves     int
+}
+
+func (r *conflictingRepo[T]) GetOrDefault(_ context.Context, _ uuid.UUID, defaultData T) (T, error) {
+	r.gets++
+	return defaultData, nil
+}
+
+func (r *conflictingRepo[T]) Save(_ context.Context, _ T) error {
+	r.saves++
+	if r.saves <= r.conflicts {
+		return repository.ErrVersionConflict
+	}
+	return nil
+}
+
+func TestMode_Update(t *testing.T) {
+	data, err := anypb.New(&pbmodel.V1BlockSumoPlayerData{BlockSlot: 3, ShearsSlot: 4})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		mask      *fieldmaskpb.FieldMask
+		lenient   bool
+		conflicts int
+
+		wantSaves int
+		wantErr   error
+	}{
+		{
+			name:      "saved first time",
+			mask:      &fieldmaskpb.FieldMask{Paths: []string{"block_slot"}},
+			wantSaves: 1,
+		},
+		{
+			name:      "retried after conflicts",
+			mask:      &fieldmaskpb.FieldMask{Paths: []string{"block_slot"}},
+			conflicts: maxUpdateAttempts - 1,
+			wantSaves: maxUpdateAttempts,
+		},
+		{
+			name:      "gives up after max attempts",
+			mask:      &fieldmaskpb.FieldMask{Paths: []string{"block_slot"}},
+			conflicts: maxUpdateAttempts,
+			wantSaves: maxUpdateAttempts,
+			wantErr:   repository.ErrVersionConflict,
+		},
+		{
+			name:      "empty mask",
+			mask:      &fieldmaskpb.FieldMask{},
+			wantSaves: 0,
+			wantErr:   ErrInvalidUpdate,
+		},
+		{
+			name:      "empty mask saves default data when lenient",
+			mask:      &fieldmaskpb.FieldMask{},
+			lenient:   true,
+			wantSaves: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &conflictingRepo[*model.BlockSumoData]{conflicts: tt.conflicts}
+			m := &mode[*pbmodel.V1BlockSumoPlayerData, *model.BlockSumoData]{
+				gameMode: pbmodel.GameDataGameMode_BLOCK_SUMO,
+				repo:     repo,
+				newData: func(playerId uuid.UUID) *model.BlockSumoData {
+					return &model.BlockSumoData{BaseGameData: model.BaseGameData{PlayerId: playerId}, ShearsSlot: 8}
+				},
+			}
+
+			got, err := m.Update(context.Background(), uuid.New(), data, tt.mask, !tt.lenient)
+			assert.Equal(t, tt.wantSaves, repo.saves)
+			assert.Equal(t, tt.wantSaves, repo.gets)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			gotData := got.(*model.BlockSumoData)
+			assert.Equal(t, uint32(8), gotData.ShearsSlot)
+			if len(tt.mask.GetPaths()) > 0 {
+				assert.Equal(t, uint32(3), gotData.BlockSlot)
+			} else {
+				assert.Equal(t, uint32(0), gotData.BlockSlot)
+			}
+		})
+	}
+}
+
+func TestMode_Update_NoFields(t *testing.T) {
+	data, err := anypb.New(&pbmodel.V1TowerDefencePlayerData{})
+	assert.NoError(t, err)
+
+	repo := &conflictingRepo[*model.TowerDefenceData]{}
+	m := &mode[*pbmodel.V1TowerDefencePlayerData, *model.TowerDefenceData]{
+		gameMode: pbmodel.GameDataGameMode_TOWER_DEFENCE,
+		repo:     repo,
+		newData: func(playerId uuid.UUID) *model.TowerDefenceData {
+			return &model.TowerDefenceData{BaseGameData: model.BaseGameData{PlayerId: playerId}}
+		},
+	}
+
+	playerId := uuid.New()
+	got, err := m.Update(context.Background(), playerId, data, &fieldmaskpb.FieldMask{}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, repo.saves)
+	assert.Equal(t, playerId, got.(*model.TowerDefenceData).PlayerId)
+}
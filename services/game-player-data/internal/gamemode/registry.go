@@ -0,0 +1,78 @@
+package gamemode
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository/model"
+	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/gameplayerdata"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Mode reads and writes the player data of one game mode
+type Mode interface {
+	GameMode() pbmodel.GameDataGameMode
+
+	// Get returns mongo.ErrNoDocuments if the player has no data
+	Get(ctx context.Context, playerId uuid.UUID) (model.GameData, error)
+	GetMultiple(ctx context.Context, playerIds []uuid.UUID) ([]model.GameData, error)
+	// Update sets the fields in mask to their values in data, creating the player's data if it doesn't exist.
+	// Returns an error wrapping ErrInvalidUpdate if data is invalid, or if strict and mask is empty or has unknown fields.
+	// The mask may always be empty if data has no fields.
+	// Without strict, unknown fields are skipped and an empty mask only creates the data, as Kafka updates always were.
+	Update(ctx context.Context, playerId uuid.UUID, data *anypb.Any, mask *fieldmaskpb.FieldMask, strict bool) (model.GameData, error)
+}
+
+type Registry struct {
+	modes map[pbmodel.GameDataGameMode]Mode
+}
+
+// NewRegistry creates a registry of every supported game mode.
+// Adding a game mode only needs a model implementing model.ProtoGameData and a registration here.
+func NewRegistry(db *mongo.Database) *Registry {
+	r := &Registry{modes: make(map[pbmodel.GameDataGameMode]Mode)}
+
+	register[*pbmodel.V1BlockSumoPlayerData](r, db, pbmodel.GameDataGameMode_BLOCK_SUMO, "blockSumo",
+		func(playerId uuid.UUID) *model.BlockSumoData {
+			return &model.BlockSumoData{BaseGameData: model.BaseGameData{PlayerId: playerId}}
+		})
+	register[*pbmodel.V1MarathonData](r, db, pbmodel.GameDataGameMode_MARATHON, "marathon",
+		func(playerId uuid.UUID) *model.MarathonData {
+			return &model.MarathonData{BaseGameData: model.BaseGameData{PlayerId: playerId}}
+		})
+	register[*pbmodel.V1MinesweeperPlayerData](r, db, pbmodel.GameDataGameMode_MINESWEEPER, "minesweeper",
+		func(playerId uuid.UUID) *model.MinesweeperData {
+			return &model.MinesweeperData{BaseGameData: model.BaseGameData{PlayerId: playerId}}
+		})
+	register[*pbmodel.V1TowerDefencePlayerData](r, db, pbmodel.GameDataGameMode_TOWER_DEFENCE, "towerDefence",
+		func(playerId uuid.UUID) *model.TowerDefenceData {
+			return &model.TowerDefenceData{BaseGameData: model.BaseGameData{PlayerId: playerId}}
+		})
+
+	return r
+}
+
+// Get returns the game mode, or false if it isn't supported
+func (r *Registry) Get(gameMode pbmodel.GameDataGameMode) (Mode, bool) {
+	m, ok := r.modes[gameMode]
+	return m, ok
+}
+
+// Update parses and applies an update, as sent over Kafka or gRPC. See Mode.Update.
+func (r *Registry) Update(ctx context.Context, playerId string, gameMode pbmodel.GameDataGameMode, data *anypb.Any,
+	mask *fieldmaskpb.FieldMask, strict bool) (model.GameData, error) {
+
+	pId, err := uuid.Parse(playerId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid player id: %w", ErrInvalidUpdate, err)
+	}
+
+	mode, ok := r.Get(gameMode)
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported game mode %s", ErrInvalidUpdate, gameMode)
+	}
+
+	return mode.Update(ctx, pId, data, mask, strict)
+}
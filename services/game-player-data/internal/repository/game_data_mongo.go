@@ -14,32 +14,13 @@ import (
 	"time"
 )
 
-const (
-	blockSumoCollection    = "blockSumo"
-	towerDefenceCollection = "towerDefence"
-	minesweeperCollection  = "minesweeper"
-	marathonCollection     = "marathon"
-)
-
-var typeToCollection = map[reflect.Type]string{
-	reflect.TypeOf(&model.BlockSumoData{}):    blockSumoCollection,
-	reflect.TypeOf(&model.TowerDefenceData{}): towerDefenceCollection,
-	reflect.TypeOf(&model.MinesweeperData{}):  minesweeperCollection,
-	reflect.TypeOf(&model.MarathonData{}):     marathonCollection,
-}
-
 type mongoGameDataRepository[T model.GameData] struct {
 	coll *mongo.Collection
 
 	example T
 }
 
-func NewMongoGameDataRepository[T model.GameData](db *mongo.Database, example T) GameDataRepository[T] {
-	collName, ok := typeToCollection[reflect.TypeOf(example)]
-	if !ok {
-		panic(fmt.Sprintf("no collection found for type %T", example))
-	}
-
+func NewMongoGameDataRepository[T model.GameData](db *mongo.Database, collName string) GameDataRepository[T] {
 	return &mongoGameDataRepository[T]{
 		coll: db.Collection(collName),
 	}
@@ -95,8 +76,23 @@ func (m *mongoGameDataRepository[T]) Save(ctx context.Context, data T) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	_, err := m.coll.ReplaceOne(ctx, bson.M{"_id": data.PlayerID()}, data, &options.ReplaceOptions{Upsert: utils.PointerOf(true)})
+	base := data.Base()
+	filter := bson.M{"_id": base.PlayerId, "version": base.Version}
+	if base.Version == 0 {
+		// The version is missing on data saved before versioning
+		filter["version"] = bson.M{"$in": bson.A{0, nil}}
+	}
+
+	base.Version++
+	_, err := m.coll.ReplaceOne(ctx, filter, data, &options.ReplaceOptions{Upsert: utils.PointerOf(true)})
 	if err != nil {
+		base.Version--
+
+		// If the version didn't match, the upsert tries to insert a document with the existing id
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrVersionConflict
+		}
+
 		return fmt.Errorf("failed to insert data: %w", err)
 	}
 
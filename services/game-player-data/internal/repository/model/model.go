@@ -4,22 +4,40 @@ import (
 	"github.com/emortalmc/mono-services/services/game-player-data/internal/utils"
 	"github.com/emortalmc/proto-specs/gen/go/model/gameplayerdata"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
 type GameData interface {
 	ToAnyProto() (*anypb.Any, error)
 	PlayerID() uuid.UUID
+	Base() *BaseGameData
+}
+
+// ProtoGameData is game data that converts to and from its proto message P
+type ProtoGameData[P proto.Message] interface {
+	GameData
+	ToProto() P
+	// FromProto replaces all the data, except the player id, with the data in pb
+	FromProto(pb P)
 }
 
 type BaseGameData struct {
 	PlayerId uuid.UUID `bson:"_id"`
+
+	// Version is incremented on every save, so a save based on outdated data can be detected.
+	// It is 0 for data that wasn't saved yet, or was saved before versioning.
+	Version int64 `bson:"version"`
 }
 
 func (d *BaseGameData) PlayerID() uuid.UUID {
 	return d.PlayerId
 }
 
+func (d *BaseGameData) Base() *BaseGameData {
+	return d
+}
+
 type BlockSumoData struct {
 	BaseGameData `bson:",inline"`
 
@@ -28,10 +46,19 @@ type BlockSumoData struct {
 }
 
 func (d *BlockSumoData) ToAnyProto() (*anypb.Any, error) {
-	return anypb.New(&gameplayerdata.V1BlockSumoPlayerData{
+	return anypb.New(d.ToProto())
+}
+
+func (d *BlockSumoData) ToProto() *gameplayerdata.V1BlockSumoPlayerData {
+	return &gameplayerdata.V1BlockSumoPlayerData{
 		BlockSlot:  d.BlockSlot,
 		ShearsSlot: d.ShearsSlot,
-	})
+	}
+}
+
+func (d *BlockSumoData) FromProto(pb *gameplayerdata.V1BlockSumoPlayerData) {
+	d.BlockSlot = pb.BlockSlot
+	d.ShearsSlot = pb.ShearsSlot
 }
 
 type MarathonData struct {
@@ -43,6 +70,10 @@ type MarathonData struct {
 }
 
 func (d *MarathonData) ToAnyProto() (*anypb.Any, error) {
+	return anypb.New(d.ToProto())
+}
+
+func (d *MarathonData) ToProto() *gameplayerdata.V1MarathonData {
 	pb := &gameplayerdata.V1MarathonData{
 		Time:         d.Time,
 		BlockPalette: d.BlockPalette,
@@ -52,25 +83,60 @@ func (d *MarathonData) ToAnyProto() (*anypb.Any, error) {
 		pb.Animation = utils.PointerOf(d.Animation)
 	}
 
-	return anypb.New(pb)
+	return pb
+}
+
+func (d *MarathonData) FromProto(pb *gameplayerdata.V1MarathonData) {
+	d.Time = pb.Time
+	d.BlockPalette = pb.BlockPalette
+	d.Animation = pb.GetAnimation()
 }
 
-// MinesweeperData TODO
 type MinesweeperData struct {
 	BaseGameData `bson:",inline"`
+
+	Length   uint32 `bson:"length"`
+	Width    uint32 `bson:"width"`
+	Mines    uint32 `bson:"mines"`
+	Theme    string `bson:"theme"`
+	Solvable bool   `bson:"solvable"`
+}
+
+func (d *MinesweeperData) ToAnyProto() (*anypb.Any, error) {
+	return anypb.New(d.ToProto())
 }
 
-// TODO
 func (d *MinesweeperData) ToProto() *gameplayerdata.V1MinesweeperPlayerData {
-	return &gameplayerdata.V1MinesweeperPlayerData{}
+	return &gameplayerdata.V1MinesweeperPlayerData{
+		Length:   d.Length,
+		Width:    d.Width,
+		Mines:    d.Mines,
+		Theme:    d.Theme,
+		Solvable: d.Solvable,
+	}
+}
+
+func (d *MinesweeperData) FromProto(pb *gameplayerdata.V1MinesweeperPlayerData) {
+	d.Length = pb.Length
+	d.Width = pb.Width
+	d.Mines = pb.Mines
+	d.Theme = pb.Theme
+	d.Solvable = pb.Solvable
 }
 
-// TowerDefenceData TODO
+// TowerDefenceData has no fields yet as V1TowerDefencePlayerData has none, so it only records that the player has data.
+// It is written with an empty mask. Storing real settings needs fields added to V1TowerDefencePlayerData in proto-specs.
 type TowerDefenceData struct {
-	PlayerId uuid.UUID `bson:"_id"`
+	BaseGameData `bson:",inline"`
+}
+
+func (d *TowerDefenceData) ToAnyProto() (*anypb.Any, error) {
+	return anypb.New(d.ToProto())
 }
 
-// TODO
 func (d *TowerDefenceData) ToProto() *gameplayerdata.V1TowerDefencePlayerData {
 	return &gameplayerdata.V1TowerDefencePlayerData{}
 }
+
+func (d *TowerDefenceData) FromProto(_ *gameplayerdata.V1TowerDefencePlayerData) {
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"github.com/emortalmc/proto-specs/gen/go/model/gameplayerdata"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMinesweeperData_ToProto(t *testing.T) {
+	tests := []struct {
+		name string
+		data *MinesweeperData
+		want *gameplayerdata.V1MinesweeperPlayerData
+	}{
+		{
+			name: "all fields",
+			data: &MinesweeperData{Length: 16, Width: 30, Mines: 99, Theme: "classic", Solvable: true},
+			want: &gameplayerdata.V1MinesweeperPlayerData{Length: 16, Width: 30, Mines: 99, Theme: "classic", Solvable: true},
+		},
+		{
+			name: "default data",
+			data: &MinesweeperData{},
+			want: &gameplayerdata.V1MinesweeperPlayerData{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.data.ToProto())
+		})
+	}
+}
+
+func TestMinesweeperData_FromProto(t *testing.T) {
+	playerId := uuid.New()
+
+	tests := []struct {
+		name string
+		data *MinesweeperData
+		pb   *gameplayerdata.V1MinesweeperPlayerData
+		want *MinesweeperData
+	}{
+		{
+			name: "replaces all fields",
+			data: &MinesweeperData{BaseGameData: BaseGameData{PlayerId: playerId, Version: 2}, Length: 9, Width: 9, Mines: 10, Theme: "dark"},
+			pb:   &gameplayerdata.V1MinesweeperPlayerData{Length: 16, Width: 30, Mines: 99, Solvable: true},
+			want: &MinesweeperData{BaseGameData: BaseGameData{PlayerId: playerId, Version: 2}, Length: 16, Width: 30, Mines: 99, Solvable: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.data.FromProto(tt.pb)
+			assert.Equal(t, tt.want, tt.data)
+		})
+	}
+}
+
+func TestTowerDefenceData_Proto(t *testing.T) {
+	playerId := uuid.New()
+	data := &TowerDefenceData{BaseGameData: BaseGameData{PlayerId: playerId, Version: 1}}
+
+	assert.Equal(t, &gameplayerdata.V1TowerDefencePlayerData{}, data.ToProto())
+
+	data.FromProto(&gameplayerdata.V1TowerDefencePlayerData{})
+	assert.Equal(t, &TowerDefenceData{BaseGameData: BaseGameData{PlayerId: playerId, Version: 1}}, data)
+
+	anyProto, err := data.ToAnyProto()
+	assert.NoError(t, err)
+	assert.True(t, anyProto.MessageIs(&gameplayerdata.V1TowerDefencePlayerData{}))
+}
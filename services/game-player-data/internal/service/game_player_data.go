@@ -3,10 +3,8 @@ package service
 import (
 	"context"
 	"errors"
-	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository"
-	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository/model"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/gamemode"
 	pb "github.com/emortalmc/proto-specs/gen/go/grpc/gameplayerdata"
-	"github.com/emortalmc/proto-specs/gen/go/model/gameplayerdata"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
@@ -18,13 +16,13 @@ import (
 type gamePlayerDataService struct {
 	pb.UnimplementedGamePlayerDataServiceServer
 
-	repos *repository.GameDataRepoColl
+	modes *gamemode.Registry
 	log   *zap.SugaredLogger
 }
 
-func newGamePlayerDataService(repos *repository.GameDataRepoColl, log *zap.SugaredLogger) pb.GamePlayerDataServiceServer {
+func newGamePlayerDataService(modes *gamemode.Registry, log *zap.SugaredLogger) pb.GamePlayerDataServiceServer {
 	return &gamePlayerDataService{
-		repos: repos,
+		modes: modes,
 		log:   log,
 	}
 }
@@ -35,16 +33,12 @@ func (s *gamePlayerDataService) GetGamePlayerData(ctx context.Context, req *pb.G
 		return nil, status.Error(codes.InvalidArgument, "invalid player id")
 	}
 
-	var data model.GameData
-	switch req.GameMode {
-	case gameplayerdata.GameDataGameMode_BLOCK_SUMO:
-		data, err = s.repos.BlockSumo.Get(ctx, pId)
-	case gameplayerdata.GameDataGameMode_MARATHON:
-		data, err = s.repos.Marathon.Get(ctx, pId)
-	default:
+	mode, ok := s.modes.Get(req.GameMode)
+	if !ok {
 		return nil, status.Error(codes.InvalidArgument, "unsupported game mode")
 	}
 
+	data, err := mode.Get(ctx, pId)
 	if err != nil {
 		return nil, s.createDbErr(err)
 	}
@@ -69,27 +63,14 @@ func (s *gamePlayerDataService) GetMultipleGamePlayerData(ctx context.Context, r
 		pIds[i] = pId
 	}
 
-	var genericData = make([]model.GameData, 0)
-
-	switch req.GameMode {
-	case gameplayerdata.GameDataGameMode_BLOCK_SUMO:
-		uncastData, err := s.repos.BlockSumo.GetMultiple(ctx, pIds)
-		if err != nil {
-			return nil, s.createDbErr(err)
-		}
-
-		for _, d := range uncastData {
-			genericData = append(genericData, d)
-		}
-	case gameplayerdata.GameDataGameMode_MARATHON:
-		uncastData, err := s.repos.Marathon.GetMultiple(ctx, pIds)
-		if err != nil {
-			return nil, s.createDbErr(err)
-		}
+	mode, ok := s.modes.Get(req.GameMode)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unsupported game mode")
+	}
 
-		for _, d := range uncastData {
-			genericData = append(genericData, d)
-		}
+	genericData, err := mode.GetMultiple(ctx, pIds)
+	if err != nil {
+		return nil, s.createDbErr(err)
 	}
 
 	data := make(map[string]*anypb.Any, len(genericData))
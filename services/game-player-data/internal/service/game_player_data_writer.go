@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/emortalmc/mono-services/services/game-player-data/gen/go/gameplayerdatapb"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/gamemode"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type gamePlayerDataWriter struct {
+	gameplayerdatapb.UnimplementedGamePlayerDataWriterServer
+
+	modes *gamemode.Registry
+	log   *zap.SugaredLogger
+}
+
+func newGamePlayerDataWriter(modes *gamemode.Registry, log *zap.SugaredLogger) gameplayerdatapb.GamePlayerDataWriterServer {
+	return &gamePlayerDataWriter{
+		modes: modes,
+		log:   log,
+	}
+}
+
+func (s *gamePlayerDataWriter) UpdateGamePlayerData(ctx context.Context, req *gameplayerdatapb.UpdateGamePlayerDataRequest) (*gameplayerdatapb.UpdateGamePlayerDataResponse, error) {
+	data, err := s.modes.Update(ctx, req.PlayerId, req.GameMode, req.Data, req.DataMask, true)
+	if err != nil {
+		if errors.Is(err, gamemode.ErrInvalidUpdate) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		s.log.Errorw("failed to update player data", "error", err, "playerId", req.PlayerId, "gameMode", req.GameMode)
+		return nil, status.Error(codes.Internal, "failed to update player data")
+	}
+
+	anyData, err := data.ToAnyProto()
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to convert data to proto")
+	}
+
+	return &gameplayerdatapb.UpdateGamePlayerDataResponse{
+		Data: anyData,
+	}, nil
+}
@@ -3,8 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"github.com/emortalmc/mono-services/services/game-player-data/gen/go/gameplayerdatapb"
 	"github.com/emortalmc/mono-services/services/game-player-data/internal/config"
-	"github.com/emortalmc/mono-services/services/game-player-data/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-player-data/internal/gamemode"
 	"github.com/emortalmc/proto-specs/gen/go/grpc/gameplayerdata"
 	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"go.uber.org/zap"
@@ -17,7 +18,7 @@ import (
 )
 
 func RunServices(ctx context.Context, logger *zap.SugaredLogger, wg *sync.WaitGroup, cfg *config.Config,
-	repos *repository.GameDataRepoColl) {
+	modes *gamemode.Registry) {
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
@@ -38,7 +39,8 @@ func RunServices(ctx context.Context, logger *zap.SugaredLogger, wg *sync.WaitGr
 		reflection.Register(s)
 	}
 
-	gameplayerdata.RegisterGamePlayerDataServiceServer(s, newGamePlayerDataService(repos, logger))
+	gameplayerdata.RegisterGamePlayerDataServiceServer(s, newGamePlayerDataService(modes, logger))
+	gameplayerdatapb.RegisterGamePlayerDataWriterServer(s, newGamePlayerDataWriter(modes, logger))
 	logger.Infow("listening for gRPC requests", "port", cfg.Port)
 
 	go func() {
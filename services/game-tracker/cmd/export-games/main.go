@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/export"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/parsers"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+	"log"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// export-games writes historic games to NDJSON or CSV for analytics.
+//
+// For a nightly incremental export, pass --cursor-file and --settle-time: each run only writes games that
+// finished after the previous run's last game, and skips games that finished too recently to be complete.
+func main() {
+	format := pflag.String("format", string(export.FormatNDJSON), "Output format, ndjson or csv")
+	output := pflag.String("output", "-", "Output file, - for stdout")
+	gameModeId := pflag.String("game-mode", "", "Only export games of this game mode")
+	from := pflag.String("from", "", "Only export games that ended at or after this RFC 3339 time")
+	to := pflag.String("to", "", "Only export games that ended before this RFC 3339 time")
+	cursorFile := pflag.String("cursor-file", "", "File storing the last exported game, to resume from on the next run")
+	settleTime := pflag.Duration("settle-time", time.Hour, "Games that ended within this duration are left for the next run, ignored if --to is set")
+
+	cfg := config.LoadGlobalConfig() // Parses the flags
+
+	unsugared, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatal(err)
+	}
+	logger := unsugared.Sugar()
+
+	opts := export.Options{
+		Format:     export.Format(*format),
+		CursorPath: *cursorFile,
+	}
+	if *gameModeId != "" {
+		opts.GameModeId = gameModeId
+	}
+	if *from != "" {
+		t, err := time.Parse(time.RFC3339, *from)
+		if err != nil {
+			logger.Fatalw("invalid from time", "error", err)
+		}
+		opts.From = &t
+	}
+	if *to != "" {
+		t, err := time.Parse(time.RFC3339, *to)
+		if err != nil {
+			logger.Fatalw("invalid to time", "error", err)
+		}
+		opts.To = &t
+	} else if *settleTime > 0 {
+		t := time.Now().Add(-*settleTime)
+		opts.To = &t
+	}
+
+	// Run closes the output, and only moves the cursor once closing it succeeded
+	out, err := export.OpenOutput(*output)
+	if err != nil {
+		logger.Fatalw("failed to open output", "error", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	parsers.RegisterDefaultDataTypes()
+
+	repoWg := &sync.WaitGroup{}
+	repoCtx, repoCancel := context.WithCancel(ctx)
+
+	repo, err := repository.NewMongoRepository(repoCtx, logger, repoWg, cfg.MongoDB)
+	if err != nil {
+		logger.Fatalw("failed to create repository", err)
+	}
+
+	result, err := export.Run(ctx, repo, opts, out)
+
+	repoCancel()
+	repoWg.Wait()
+
+	if err != nil {
+		logger.Fatalw("failed to export historic games", "error", err)
+	}
+	logger.Infow("exported historic games", "games", result.Games, "cursor", result.Cursor)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/parsers"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/stats"
+	"go.uber.org/zap"
+	"log"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// rebuild-stats recomputes every player's aggregated stats, the leaderboards and the map stats from the historic games.
+// Stop the game-tracker consumer before running it, otherwise games finished during the rebuild may be miscounted.
+func main() {
+	cfg := config.LoadGlobalConfig()
+
+	unsugared, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatal(err)
+	}
+	logger := unsugared.Sugar()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	parsers.RegisterDefaultDataTypes()
+
+	repoWg := &sync.WaitGroup{}
+	repoCtx, repoCancel := context.WithCancel(ctx)
+
+	repo, err := repository.NewMongoRepository(repoCtx, logger, repoWg, cfg.MongoDB)
+	if err != nil {
+		logger.Fatalw("failed to create repository", err)
+	}
+
+	result, err := stats.Rebuild(ctx, logger, repo)
+	if err != nil {
+		logger.Errorw("failed to rebuild player stats", "error", err)
+	} else {
+		logger.Infow("rebuilt player stats", "games", result.Games, "playerStats", result.PlayerStats,
+			"leaderboardEntries", result.LeaderboardEntries, "mapStats", result.MapStats)
+	}
+
+	repoCancel()
+	repoWg.Wait()
+}
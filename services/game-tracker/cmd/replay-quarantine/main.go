@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/app"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/parsers"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/spf13/pflag"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"log"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// replay-quarantine passes the game messages the consumer quarantined, because it failed to handle them, back to its
+// handlers using the same config as the game tracker. Run it once the fix for the failure is deployed, replaying stops
+// at the first message that fails again.
+func main() {
+	idStrings := pflag.StringSlice("id", nil, "Id of a quarantined message to replay, may be repeated")
+	all := pflag.Bool("all", false, "Replay every quarantined message that wasn't replayed yet")
+	dryRun := pflag.Bool("dry-run", false, "Only log the messages that would be replayed")
+
+	cfg := config.LoadGlobalConfig() // Parses the flags
+
+	unsugared, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatal(err)
+	}
+	logger := unsugared.Sugar()
+
+	if len(*idStrings) == 0 && !*all {
+		logger.Fatal("either --id or --all must be set")
+	}
+	if len(*idStrings) > 0 && *all {
+		logger.Fatal("--id and --all can't be used together")
+	}
+
+	ids := make([]primitive.ObjectID, len(*idStrings))
+	for i, idString := range *idStrings {
+		id, err := primitive.ObjectIDFromHex(idString)
+		if err != nil {
+			logger.Fatalw("invalid message id", "id", idString, "error", err)
+		}
+		ids[i] = id
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Registers the game data types, which the repository needs to read games
+	parserRegistry := parsers.NewDefaultRegistry()
+
+	repoWg := &sync.WaitGroup{}
+	repoCtx, repoCancel := context.WithCancel(ctx)
+
+	repo, err := repository.NewMongoRepository(repoCtx, logger, repoWg, cfg.MongoDB)
+	if err != nil {
+		logger.Fatalw("failed to create repository", err)
+	}
+
+	replayed, err := app.ReplayQuarantined(ctx, cfg, logger, repo, parserRegistry, ids, *dryRun)
+
+	repoCancel()
+	repoWg.Wait()
+
+	if err != nil {
+		logger.Fatalw("failed to replay quarantined messages", "replayed", replayed, "error", err)
+	}
+	logger.Infow("replayed quarantined messages", "replayed", replayed, "dryRun", *dryRun)
+}
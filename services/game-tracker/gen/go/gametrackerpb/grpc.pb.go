@@ -0,0 +1,2985 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.0
+// source: gametracker/grpc.proto
+
+package gametrackerpb
+
+import (
+	gametracker "github.com/emortalmc/proto-specs/gen/go/model/gametracker"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GameOutcome int32
+
+const (
+	// FINISHED the game sent a finish message
+	GameOutcome_FINISHED GameOutcome = 0
+	// ABANDONED the game stopped sending updates, e.g. because its server crashed
+	GameOutcome_ABANDONED GameOutcome = 1
+)
+
+// Enum value maps for GameOutcome.
+var (
+	GameOutcome_name = map[int32]string{
+		0: "FINISHED",
+		1: "ABANDONED",
+	}
+	GameOutcome_value = map[string]int32{
+		"FINISHED":  0,
+		"ABANDONED": 1,
+	}
+)
+
+func (x GameOutcome) Enum() *GameOutcome {
+	p := new(GameOutcome)
+	*p = x
+	return p
+}
+
+func (x GameOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_gametracker_grpc_proto_enumTypes[0].Descriptor()
+}
+
+func (GameOutcome) Type() protoreflect.EnumType {
+	return &file_gametracker_grpc_proto_enumTypes[0]
+}
+
+func (x GameOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameOutcome.Descriptor instead.
+func (GameOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{0}
+}
+
+type LeaderboardMetric int32
+
+const (
+	LeaderboardMetric_WINS         LeaderboardMetric = 0
+	LeaderboardMetric_KILLS        LeaderboardMetric = 1
+	LeaderboardMetric_GAMES_PLAYED LeaderboardMetric = 2
+)
+
+// Enum value maps for LeaderboardMetric.
+var (
+	LeaderboardMetric_name = map[int32]string{
+		0: "WINS",
+		1: "KILLS",
+		2: "GAMES_PLAYED",
+	}
+	LeaderboardMetric_value = map[string]int32{
+		"WINS":         0,
+		"KILLS":        1,
+		"GAMES_PLAYED": 2,
+	}
+)
+
+func (x LeaderboardMetric) Enum() *LeaderboardMetric {
+	p := new(LeaderboardMetric)
+	*p = x
+	return p
+}
+
+func (x LeaderboardMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_gametracker_grpc_proto_enumTypes[1].Descriptor()
+}
+
+func (LeaderboardMetric) Type() protoreflect.EnumType {
+	return &file_gametracker_grpc_proto_enumTypes[1]
+}
+
+func (x LeaderboardMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardMetric.Descriptor instead.
+func (LeaderboardMetric) EnumDescriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{1}
+}
+
+// LeaderboardWindow is the period a leaderboard covers. Periods are in UTC and weeks start on Monday.
+type LeaderboardWindow int32
+
+const (
+	LeaderboardWindow_ALL_TIME LeaderboardWindow = 0
+	LeaderboardWindow_MONTHLY  LeaderboardWindow = 1
+	LeaderboardWindow_WEEKLY   LeaderboardWindow = 2
+	LeaderboardWindow_DAILY    LeaderboardWindow = 3
+)
+
+// Enum value maps for LeaderboardWindow.
+var (
+	LeaderboardWindow_name = map[int32]string{
+		0: "ALL_TIME",
+		1: "MONTHLY",
+		2: "WEEKLY",
+		3: "DAILY",
+	}
+	LeaderboardWindow_value = map[string]int32{
+		"ALL_TIME": 0,
+		"MONTHLY":  1,
+		"WEEKLY":   2,
+		"DAILY":    3,
+	}
+)
+
+func (x LeaderboardWindow) Enum() *LeaderboardWindow {
+	p := new(LeaderboardWindow)
+	*p = x
+	return p
+}
+
+func (x LeaderboardWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_gametracker_grpc_proto_enumTypes[2].Descriptor()
+}
+
+func (LeaderboardWindow) Type() protoreflect.EnumType {
+	return &file_gametracker_grpc_proto_enumTypes[2]
+}
+
+func (x LeaderboardWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardWindow.Descriptor instead.
+func (LeaderboardWindow) EnumDescriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{2}
+}
+
+type LiveGameEvent_Type int32
+
+const (
+	LiveGameEvent_ADDED   LiveGameEvent_Type = 0
+	LiveGameEvent_UPDATED LiveGameEvent_Type = 1
+	// REMOVED the game finished or no longer matches the filter
+	LiveGameEvent_REMOVED LiveGameEvent_Type = 2
+)
+
+// Enum value maps for LiveGameEvent_Type.
+var (
+	LiveGameEvent_Type_name = map[int32]string{
+		0: "ADDED",
+		1: "UPDATED",
+		2: "REMOVED",
+	}
+	LiveGameEvent_Type_value = map[string]int32{
+		"ADDED":   0,
+		"UPDATED": 1,
+		"REMOVED": 2,
+	}
+)
+
+func (x LiveGameEvent_Type) Enum() *LiveGameEvent_Type {
+	p := new(LiveGameEvent_Type)
+	*p = x
+	return p
+}
+
+func (x LiveGameEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LiveGameEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_gametracker_grpc_proto_enumTypes[3].Descriptor()
+}
+
+func (LiveGameEvent_Type) Type() protoreflect.EnumType {
+	return &file_gametracker_grpc_proto_enumTypes[3]
+}
+
+func (x LiveGameEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LiveGameEvent_Type.Descriptor instead.
+func (LiveGameEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type LiveGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameModeId  string                         `protobuf:"bytes,2,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	ServerId    string                         `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	StartTime   *timestamppb.Timestamp         `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	LastUpdated *timestamppb.Timestamp         `protobuf:"bytes,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Players     []*gametracker.BasicGamePlayer `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`
+	Teams       []*gametracker.Team            `protobuf:"bytes,7,rep,name=teams,proto3" json:"teams,omitempty"`
+	// game_data is the latest update data sent by the game (e.g. BlockSumoUpdateData), if any.
+	GameData *anypb.Any `protobuf:"bytes,8,opt,name=game_data,json=gameData,proto3,oneof" json:"game_data,omitempty"`
+	// raw_content is content the game tracker has no parser for
+	RawContent []*anypb.Any `protobuf:"bytes,9,rep,name=raw_content,json=rawContent,proto3" json:"raw_content,omitempty"`
+	MapId      *string      `protobuf:"bytes,10,opt,name=map_id,json=mapId,proto3,oneof" json:"map_id,omitempty"`
+	// match_id is the matchmaker match the game was allocated for
+	MatchId *string `protobuf:"bytes,11,opt,name=match_id,json=matchId,proto3,oneof" json:"match_id,omitempty"`
+	Private bool    `protobuf:"varint,12,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *LiveGame) Reset() {
+	*x = LiveGame{}
+	mi := &file_gametracker_grpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveGame) ProtoMessage() {}
+
+func (x *LiveGame) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveGame.ProtoReflect.Descriptor instead.
+func (*LiveGame) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LiveGame) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LiveGame) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *LiveGame) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *LiveGame) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *LiveGame) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *LiveGame) GetPlayers() []*gametracker.BasicGamePlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *LiveGame) GetTeams() []*gametracker.Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *LiveGame) GetGameData() *anypb.Any {
+	if x != nil {
+		return x.GameData
+	}
+	return nil
+}
+
+func (x *LiveGame) GetRawContent() []*anypb.Any {
+	if x != nil {
+		return x.RawContent
+	}
+	return nil
+}
+
+func (x *LiveGame) GetMapId() string {
+	if x != nil && x.MapId != nil {
+		return *x.MapId
+	}
+	return ""
+}
+
+func (x *LiveGame) GetMatchId() string {
+	if x != nil && x.MatchId != nil {
+		return *x.MatchId
+	}
+	return ""
+}
+
+func (x *LiveGame) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type HistoricGame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameModeId string                                  `protobuf:"bytes,2,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	ServerId   string                                  `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	StartTime  *timestamppb.Timestamp                  `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp                  `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Players    []*gametracker.BasicGamePlayer          `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`
+	Teams      []*gametracker.Team                     `protobuf:"bytes,7,rep,name=teams,proto3" json:"teams,omitempty"`
+	WinnerData *gametracker.CommonGameFinishWinnerData `protobuf:"bytes,8,opt,name=winner_data,json=winnerData,proto3,oneof" json:"winner_data,omitempty"`
+	// game_data is the finish data sent by the game (e.g. BlockSumoFinishData), if any.
+	// Abandoned games have the last update data instead (e.g. BlockSumoUpdateData).
+	GameData *anypb.Any  `protobuf:"bytes,9,opt,name=game_data,json=gameData,proto3,oneof" json:"game_data,omitempty"`
+	Outcome  GameOutcome `protobuf:"varint,10,opt,name=outcome,proto3,enum=emortal.gametracker.grpc.GameOutcome" json:"outcome,omitempty"`
+	// raw_content is content the game tracker has no parser for
+	RawContent []*anypb.Any `protobuf:"bytes,11,rep,name=raw_content,json=rawContent,proto3" json:"raw_content,omitempty"`
+	// summarized_at is set once retention reduced the game to a summary.
+	// Summarized games no longer have game data or raw content.
+	SummarizedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=summarized_at,json=summarizedAt,proto3,oneof" json:"summarized_at,omitempty"`
+	MapId        *string                `protobuf:"bytes,13,opt,name=map_id,json=mapId,proto3,oneof" json:"map_id,omitempty"`
+	// match_id is the matchmaker match the game was allocated for
+	MatchId *string `protobuf:"bytes,14,opt,name=match_id,json=matchId,proto3,oneof" json:"match_id,omitempty"`
+	Private bool    `protobuf:"varint,15,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *HistoricGame) Reset() {
+	*x = HistoricGame{}
+	mi := &file_gametracker_grpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoricGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricGame) ProtoMessage() {}
+
+func (x *HistoricGame) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricGame.ProtoReflect.Descriptor instead.
+func (*HistoricGame) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HistoricGame) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HistoricGame) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *HistoricGame) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *HistoricGame) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *HistoricGame) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *HistoricGame) GetPlayers() []*gametracker.BasicGamePlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *HistoricGame) GetTeams() []*gametracker.Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *HistoricGame) GetWinnerData() *gametracker.CommonGameFinishWinnerData {
+	if x != nil {
+		return x.WinnerData
+	}
+	return nil
+}
+
+func (x *HistoricGame) GetGameData() *anypb.Any {
+	if x != nil {
+		return x.GameData
+	}
+	return nil
+}
+
+func (x *HistoricGame) GetOutcome() GameOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return GameOutcome_FINISHED
+}
+
+func (x *HistoricGame) GetRawContent() []*anypb.Any {
+	if x != nil {
+		return x.RawContent
+	}
+	return nil
+}
+
+func (x *HistoricGame) GetSummarizedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SummarizedAt
+	}
+	return nil
+}
+
+func (x *HistoricGame) GetMapId() string {
+	if x != nil && x.MapId != nil {
+		return *x.MapId
+	}
+	return ""
+}
+
+func (x *HistoricGame) GetMatchId() string {
+	if x != nil && x.MatchId != nil {
+		return *x.MatchId
+	}
+	return ""
+}
+
+func (x *HistoricGame) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type ListLiveGamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId *string `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3,oneof" json:"game_mode_id,omitempty"`
+	ServerId   *string `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3,oneof" json:"server_id,omitempty"`
+}
+
+func (x *ListLiveGamesRequest) Reset() {
+	*x = ListLiveGamesRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLiveGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiveGamesRequest) ProtoMessage() {}
+
+func (x *ListLiveGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiveGamesRequest.ProtoReflect.Descriptor instead.
+func (*ListLiveGamesRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListLiveGamesRequest) GetGameModeId() string {
+	if x != nil && x.GameModeId != nil {
+		return *x.GameModeId
+	}
+	return ""
+}
+
+func (x *ListLiveGamesRequest) GetServerId() string {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return ""
+}
+
+type ListLiveGamesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*LiveGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *ListLiveGamesResponse) Reset() {
+	*x = ListLiveGamesResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLiveGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLiveGamesResponse) ProtoMessage() {}
+
+func (x *ListLiveGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLiveGamesResponse.ProtoReflect.Descriptor instead.
+func (*ListLiveGamesResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListLiveGamesResponse) GetGames() []*LiveGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type WatchLiveGamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId *string `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3,oneof" json:"game_mode_id,omitempty"`
+	// player_ids only matches games containing any of the players, e.g. a player's friends. Empty matches every game.
+	PlayerIds []string `protobuf:"bytes,2,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+}
+
+func (x *WatchLiveGamesRequest) Reset() {
+	*x = WatchLiveGamesRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLiveGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLiveGamesRequest) ProtoMessage() {}
+
+func (x *WatchLiveGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLiveGamesRequest.ProtoReflect.Descriptor instead.
+func (*WatchLiveGamesRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchLiveGamesRequest) GetGameModeId() string {
+	if x != nil && x.GameModeId != nil {
+		return *x.GameModeId
+	}
+	return ""
+}
+
+func (x *WatchLiveGamesRequest) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+type LiveGameEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type LiveGameEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=emortal.gametracker.grpc.LiveGameEvent_Type" json:"type,omitempty"`
+	// game is the last known state of the game
+	Game *LiveGame `protobuf:"bytes,2,opt,name=game,proto3" json:"game,omitempty"`
+	// elapsed is the time since the game started when the event was sent, unset if the start hasn't been received
+	Elapsed *durationpb.Duration `protobuf:"bytes,3,opt,name=elapsed,proto3,oneof" json:"elapsed,omitempty"`
+}
+
+func (x *LiveGameEvent) Reset() {
+	*x = LiveGameEvent{}
+	mi := &file_gametracker_grpc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveGameEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveGameEvent) ProtoMessage() {}
+
+func (x *LiveGameEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveGameEvent.ProtoReflect.Descriptor instead.
+func (*LiveGameEvent) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LiveGameEvent) GetType() LiveGameEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return LiveGameEvent_ADDED
+}
+
+func (x *LiveGameEvent) GetGame() *LiveGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *LiveGameEvent) GetElapsed() *durationpb.Duration {
+	if x != nil {
+		return x.Elapsed
+	}
+	return nil
+}
+
+type GetHistoricGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetHistoricGameRequest) Reset() {
+	*x = GetHistoricGameRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoricGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoricGameRequest) ProtoMessage() {}
+
+func (x *GetHistoricGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoricGameRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoricGameRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetHistoricGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetHistoricGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Game *HistoricGame `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+}
+
+func (x *GetHistoricGameResponse) Reset() {
+	*x = GetHistoricGameResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoricGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoricGameResponse) ProtoMessage() {}
+
+func (x *GetHistoricGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoricGameResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoricGameResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHistoricGameResponse) GetGame() *HistoricGame {
+	if x != nil {
+		return x.Game
+	}
+	return nil
+}
+
+type GetGameTimelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+}
+
+func (x *GetGameTimelineRequest) Reset() {
+	*x = GetGameTimelineRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameTimelineRequest) ProtoMessage() {}
+
+func (x *GetGameTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetGameTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetGameTimelineRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+type GetGameTimelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timeline *GameTimeline `protobuf:"bytes,1,opt,name=timeline,proto3" json:"timeline,omitempty"`
+}
+
+func (x *GetGameTimelineResponse) Reset() {
+	*x = GetGameTimelineResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameTimelineResponse) ProtoMessage() {}
+
+func (x *GetGameTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetGameTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetGameTimelineResponse) GetTimeline() *GameTimeline {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+type GetPlayerGameHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId   string  `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameModeId *string `protobuf:"bytes,2,opt,name=game_mode_id,json=gameModeId,proto3,oneof" json:"game_mode_id,omitempty"`
+	// from and to filter on the end time of the game. from is inclusive, to is exclusive.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3,oneof" json:"to,omitempty"`
+	// page_size defaults to 20 and is capped at 100
+	PageSize uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of a previous response. Filters must not change between pages.
+	PageToken *string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+}
+
+func (x *GetPlayerGameHistoryRequest) Reset() {
+	*x = GetPlayerGameHistoryRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerGameHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerGameHistoryRequest) ProtoMessage() {}
+
+func (x *GetPlayerGameHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerGameHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerGameHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPlayerGameHistoryRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetPlayerGameHistoryRequest) GetGameModeId() string {
+	if x != nil && x.GameModeId != nil {
+		return *x.GameModeId
+	}
+	return ""
+}
+
+func (x *GetPlayerGameHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetPlayerGameHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetPlayerGameHistoryRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPlayerGameHistoryRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type GetPlayerGameHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Games []*HistoricGame `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	// next_page_token is only set if there may be more games
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+}
+
+func (x *GetPlayerGameHistoryResponse) Reset() {
+	*x = GetPlayerGameHistoryResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerGameHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerGameHistoryResponse) ProtoMessage() {}
+
+func (x *GetPlayerGameHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerGameHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerGameHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPlayerGameHistoryResponse) GetGames() []*HistoricGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+func (x *GetPlayerGameHistoryResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+type PlayerGameModeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId  string               `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	GamesPlayed int64                `protobuf:"varint,2,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	Wins        int64                `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses      int64                `protobuf:"varint,4,opt,name=losses,proto3" json:"losses,omitempty"`
+	Playtime    *durationpb.Duration `protobuf:"bytes,5,opt,name=playtime,proto3" json:"playtime,omitempty"`
+	// counters are game mode specific totals, e.g. kills and finalKills for Block Sumo
+	Counters map[string]int64 `protobuf:"bytes,6,rep,name=counters,proto3" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *PlayerGameModeStats) Reset() {
+	*x = PlayerGameModeStats{}
+	mi := &file_gametracker_grpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerGameModeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerGameModeStats) ProtoMessage() {}
+
+func (x *PlayerGameModeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerGameModeStats.ProtoReflect.Descriptor instead.
+func (*PlayerGameModeStats) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlayerGameModeStats) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *PlayerGameModeStats) GetGamesPlayed() int64 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *PlayerGameModeStats) GetWins() int64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *PlayerGameModeStats) GetLosses() int64 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *PlayerGameModeStats) GetPlaytime() *durationpb.Duration {
+	if x != nil {
+		return x.Playtime
+	}
+	return nil
+}
+
+func (x *PlayerGameModeStats) GetCounters() map[string]int64 {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type GetPlayerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId   string  `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameModeId *string `protobuf:"bytes,2,opt,name=game_mode_id,json=gameModeId,proto3,oneof" json:"game_mode_id,omitempty"`
+}
+
+func (x *GetPlayerStatsRequest) Reset() {
+	*x = GetPlayerStatsRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsRequest) ProtoMessage() {}
+
+func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPlayerStatsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetPlayerStatsRequest) GetGameModeId() string {
+	if x != nil && x.GameModeId != nil {
+		return *x.GameModeId
+	}
+	return ""
+}
+
+type GetPlayerStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*PlayerGameModeStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetPlayerStatsResponse) Reset() {
+	*x = GetPlayerStatsResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsResponse) ProtoMessage() {}
+
+func (x *GetPlayerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPlayerStatsResponse) GetStats() []*PlayerGameModeStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type TeamColorStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// color is the Team.color of the teams counted
+	Color int32 `protobuf:"varint,1,opt,name=color,proto3" json:"color,omitempty"`
+	// games is how many teams of this colour played in games with a result
+	Games int64 `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	Wins  int64 `protobuf:"varint,3,opt,name=wins,proto3" json:"wins,omitempty"`
+	// win_rate is wins / games, 0 if there are no games
+	WinRate float64 `protobuf:"fixed64,4,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+}
+
+func (x *TeamColorStats) Reset() {
+	*x = TeamColorStats{}
+	mi := &file_gametracker_grpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamColorStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamColorStats) ProtoMessage() {}
+
+func (x *TeamColorStats) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamColorStats.ProtoReflect.Descriptor instead.
+func (*TeamColorStats) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TeamColorStats) GetColor() int32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *TeamColorStats) GetGames() int64 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *TeamColorStats) GetWins() int64 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TeamColorStats) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+type MapStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId  string `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	MapId       string `protobuf:"bytes,2,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	GamesPlayed int64  `protobuf:"varint,3,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	// average_duration only includes games with a start time
+	AverageDuration *durationpb.Duration `protobuf:"bytes,4,opt,name=average_duration,json=averageDuration,proto3" json:"average_duration,omitempty"`
+	// team_colors is sorted by color
+	TeamColors []*TeamColorStats `protobuf:"bytes,5,rep,name=team_colors,json=teamColors,proto3" json:"team_colors,omitempty"`
+}
+
+func (x *MapStats) Reset() {
+	*x = MapStats{}
+	mi := &file_gametracker_grpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MapStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapStats) ProtoMessage() {}
+
+func (x *MapStats) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapStats.ProtoReflect.Descriptor instead.
+func (*MapStats) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MapStats) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *MapStats) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+func (x *MapStats) GetGamesPlayed() int64 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *MapStats) GetAverageDuration() *durationpb.Duration {
+	if x != nil {
+		return x.AverageDuration
+	}
+	return nil
+}
+
+func (x *MapStats) GetTeamColors() []*TeamColorStats {
+	if x != nil {
+		return x.TeamColors
+	}
+	return nil
+}
+
+type GetMapStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId string  `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	MapId      *string `protobuf:"bytes,2,opt,name=map_id,json=mapId,proto3,oneof" json:"map_id,omitempty"`
+}
+
+func (x *GetMapStatsRequest) Reset() {
+	*x = GetMapStatsRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMapStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapStatsRequest) ProtoMessage() {}
+
+func (x *GetMapStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMapStatsRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMapStatsRequest) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *GetMapStatsRequest) GetMapId() string {
+	if x != nil && x.MapId != nil {
+		return *x.MapId
+	}
+	return ""
+}
+
+type GetMapStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// maps are sorted by games played, most first
+	Maps []*MapStats `protobuf:"bytes,1,rep,name=maps,proto3" json:"maps,omitempty"`
+}
+
+func (x *GetMapStatsResponse) Reset() {
+	*x = GetMapStatsResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMapStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMapStatsResponse) ProtoMessage() {}
+
+func (x *GetMapStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMapStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMapStatsResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetMapStatsResponse) GetMaps() []*MapStats {
+	if x != nil {
+		return x.Maps
+	}
+	return nil
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rank starts at 1. Players with the same score share a rank.
+	Rank     uint32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Score    int64  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_gametracker_grpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LeaderboardEntry) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId string            `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	Metric     LeaderboardMetric `protobuf:"varint,2,opt,name=metric,proto3,enum=emortal.gametracker.grpc.LeaderboardMetric" json:"metric,omitempty"`
+	Window     LeaderboardWindow `protobuf:"varint,3,opt,name=window,proto3,enum=emortal.gametracker.grpc.LeaderboardWindow" json:"window,omitempty"`
+	// previous_period returns the final standings of the period before the current one. Ignored for ALL_TIME.
+	PreviousPeriod bool `protobuf:"varint,4,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
+	// limit defaults to 10 and is capped at 100
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetLeaderboardRequest) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetMetric() LeaderboardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return LeaderboardMetric_WINS
+}
+
+func (x *GetLeaderboardRequest) GetWindow() LeaderboardWindow {
+	if x != nil {
+		return x.Window
+	}
+	return LeaderboardWindow_ALL_TIME
+}
+
+func (x *GetLeaderboardRequest) GetPreviousPeriod() bool {
+	if x != nil {
+		return x.PreviousPeriod
+	}
+	return false
+}
+
+func (x *GetLeaderboardRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// period_start and period_end are unset for ALL_TIME
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3,oneof" json:"period_start,omitempty"`
+	PeriodEnd   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_end,json=periodEnd,proto3,oneof" json:"period_end,omitempty"`
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *GetLeaderboardResponse) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+type GetLeaderboardRankRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId       string            `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameModeId     string            `protobuf:"bytes,2,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	Metric         LeaderboardMetric `protobuf:"varint,3,opt,name=metric,proto3,enum=emortal.gametracker.grpc.LeaderboardMetric" json:"metric,omitempty"`
+	Window         LeaderboardWindow `protobuf:"varint,4,opt,name=window,proto3,enum=emortal.gametracker.grpc.LeaderboardWindow" json:"window,omitempty"`
+	PreviousPeriod bool              `protobuf:"varint,5,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
+}
+
+func (x *GetLeaderboardRankRequest) Reset() {
+	*x = GetLeaderboardRankRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRankRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRankRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRankRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRankRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRankRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetLeaderboardRankRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRankRequest) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRankRequest) GetMetric() LeaderboardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return LeaderboardMetric_WINS
+}
+
+func (x *GetLeaderboardRankRequest) GetWindow() LeaderboardWindow {
+	if x != nil {
+		return x.Window
+	}
+	return LeaderboardWindow_ALL_TIME
+}
+
+func (x *GetLeaderboardRankRequest) GetPreviousPeriod() bool {
+	if x != nil {
+		return x.PreviousPeriod
+	}
+	return false
+}
+
+type GetLeaderboardRankResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// entry is unset if the player has no score in the period
+	Entry *LeaderboardEntry `protobuf:"bytes,1,opt,name=entry,proto3,oneof" json:"entry,omitempty"`
+}
+
+func (x *GetLeaderboardRankResponse) Reset() {
+	*x = GetLeaderboardRankResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRankResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRankResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardRankResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRankResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRankResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetLeaderboardRankResponse) GetEntry() *LeaderboardEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type TimelineEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// teams is only set if teams_changed is true
+	TeamsChanged bool                `protobuf:"varint,2,opt,name=teams_changed,json=teamsChanged,proto3" json:"teams_changed,omitempty"`
+	Teams        []*gametracker.Team `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+	// game_data is the full game data (e.g. BlockSumoUpdateData), set if it changed since the previous entry.
+	// It may also be set when unchanged, and is missing from the oldest entries of long games whose earlier
+	// entries were dropped.
+	GameData *anypb.Any `protobuf:"bytes,4,opt,name=game_data,json=gameData,proto3,oneof" json:"game_data,omitempty"`
+}
+
+func (x *TimelineEntry) Reset() {
+	*x = TimelineEntry{}
+	mi := &file_gametracker_grpc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEntry) ProtoMessage() {}
+
+func (x *TimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEntry.ProtoReflect.Descriptor instead.
+func (*TimelineEntry) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TimelineEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetTeamsChanged() bool {
+	if x != nil {
+		return x.TeamsChanged
+	}
+	return false
+}
+
+func (x *TimelineEntry) GetTeams() []*gametracker.Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *TimelineEntry) GetGameData() *anypb.Any {
+	if x != nil {
+		return x.GameData
+	}
+	return nil
+}
+
+type GameTimeline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// entries are oldest first. Only the newest entries are kept for long games.
+	Entries []*TimelineEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GameTimeline) Reset() {
+	*x = GameTimeline{}
+	mi := &file_gametracker_grpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameTimeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameTimeline) ProtoMessage() {}
+
+func (x *GameTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameTimeline.ProtoReflect.Descriptor instead.
+func (*GameTimeline) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GameTimeline) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameTimeline) GetEntries() []*TimelineEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Review is a game or player flagged by an anomaly check.
+// Game reviews have a game_id, player reviews of a game also have a player_id, and player reviews of rolling stats
+// only have a player_id.
+type Review struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GameModeId string  `protobuf:"bytes,2,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	GameId     *string `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3,oneof" json:"game_id,omitempty"`
+	PlayerId   *string `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	// check is the check that failed: minDuration, maxCounter:<counter>, recentWinRate or winRateIncrease
+	Check string `protobuf:"bytes,5,opt,name=check,proto3" json:"check,omitempty"`
+	// value broke the threshold of the check
+	Value      float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Threshold  float64                `protobuf:"fixed64,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resolved_at,json=resolvedAt,proto3,oneof" json:"resolved_at,omitempty"`
+	ResolvedBy *string                `protobuf:"bytes,10,opt,name=resolved_by,json=resolvedBy,proto3,oneof" json:"resolved_by,omitempty"`
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_gametracker_grpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *Review) GetGameId() string {
+	if x != nil && x.GameId != nil {
+		return *x.GameId
+	}
+	return ""
+}
+
+func (x *Review) GetPlayerId() string {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return ""
+}
+
+func (x *Review) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *Review) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Review) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Review) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *Review) GetResolvedBy() string {
+	if x != nil && x.ResolvedBy != nil {
+		return *x.ResolvedBy
+	}
+	return ""
+}
+
+type ListReviewsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId      *string `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3,oneof" json:"game_mode_id,omitempty"`
+	PlayerId        *string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	IncludeResolved bool    `protobuf:"varint,3,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	// page_size defaults to 20 and is capped at 100
+	PageSize  uint32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+}
+
+func (x *ListReviewsRequest) Reset() {
+	*x = ListReviewsRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsRequest) ProtoMessage() {}
+
+func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListReviewsRequest) GetGameModeId() string {
+	if x != nil && x.GameModeId != nil {
+		return *x.GameModeId
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetPlayerId() string {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+func (x *ListReviewsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type ListReviewsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reviews []*Review `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	// next_page_token is only set if there may be more reviews
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3,oneof" json:"next_page_token,omitempty"`
+}
+
+func (x *ListReviewsResponse) Reset() {
+	*x = ListReviewsResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsResponse) ProtoMessage() {}
+
+func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+type ResolveReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewId string `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	// resolved_by is who resolved the review, e.g. a staff member's player id
+	ResolvedBy string `protobuf:"bytes,2,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+}
+
+func (x *ResolveReviewRequest) Reset() {
+	*x = ResolveReviewRequest{}
+	mi := &file_gametracker_grpc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReviewRequest) ProtoMessage() {}
+
+func (x *ResolveReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReviewRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReviewRequest) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResolveReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *ResolveReviewRequest) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+type ResolveReviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *Review `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *ResolveReviewResponse) Reset() {
+	*x = ResolveReviewResponse{}
+	mi := &file_gametracker_grpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReviewResponse) ProtoMessage() {}
+
+func (x *ResolveReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_grpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReviewResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReviewResponse) Descriptor() ([]byte, []int) {
+	return file_gametracker_grpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResolveReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+var File_gametracker_grpc_proto protoreflect.FileDescriptor
+
+var file_gametracker_grpc_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x04, 0x0a, 0x08, 0x4c, 0x69,
+	0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61,
+	0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x48, 0x01, 0x52, 0x08,
+	0x67, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0b, 0x72,
+	0x61, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e,
+	0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x22, 0xd4, 0x06,
+	0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20,
+	0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3e, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65,
+	0x61, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x47, 0x61, 0x6d, 0x65,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61,
+	0x48, 0x01, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01,
+	0x01, 0x12, 0x36, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x48, 0x02, 0x52, 0x08, 0x67, 0x61,
+	0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x72, 0x61,
+	0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0a, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x44, 0x0a, 0x0d, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x22, 0x7e, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x76, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0c,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x76, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x76, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x67,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6d, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67,
+	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x07, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x22, 0x2b, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65,
+	0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9d, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd3, 0x02,
+	0x0a, 0x13, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67,
+	0x61, 0x6d, 0x65, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x57, 0x0a,
+	0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0c, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69,
+	0x64, 0x22, 0x5d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0x6b, 0x0a, 0x0e, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x77, 0x69,
+	0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x22, 0xf7, 0x01,
+	0x0a, 0x08, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61,
+	0x70, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x0b,
+	0x74, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x61,
+	0x6d, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x0a, 0x74, 0x65, 0x61,
+	0x6d, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x22, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x04, 0x6d, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6d,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x04, 0x6d, 0x61, 0x70, 0x73, 0x22, 0x59, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x82, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x43, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x82, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x09, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x22, 0x8d, 0x02, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x61, 0x6e,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x43, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x6d, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x61, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xe2, 0x01, 0x0a, 0x0d, 0x54, 0x69,
+	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x36, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x61,
+	0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x48, 0x00, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6a,
+	0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa1, 0x03, 0x0a, 0x06, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x42,
+	0x79, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0xf7,
+	0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x67,
+	0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29,
+	0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x2b, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x54, 0x0a,
+	0x14, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x42, 0x79, 0x22, 0x51, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2a, 0x2a, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44,
+	0x10, 0x01, 0x2a, 0x3a, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x49, 0x4e, 0x53, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4b, 0x49, 0x4c, 0x4c, 0x53, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x47, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x45,
+	0x0a, 0x11, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x41,
+	0x49, 0x4c, 0x59, 0x10, 0x03, 0x32, 0xe3, 0x04, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x70, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x47,
+	0x61, 0x6d, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x35, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x30, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a,
+	0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x2f, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4c, 0x69, 0x76, 0x65, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x76, 0x65,
+	0x47, 0x61, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x32, 0x82, 0x01, 0x0a, 0x0b,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x73, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2f, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x7a, 0x0a, 0x0c, 0x4d, 0x61, 0x70, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x6a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x2c, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xeb, 0x01, 0x0a,
+	0x0b, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x6a, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x6d,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x6d, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2e, 0x2e, 0x65, 0x6d, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x65, 0x6d, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x83, 0x02, 0x0a, 0x0b, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x73, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x2f, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x7f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x33, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x86, 0x01, 0x0a, 0x20, 0x64, 0x65, 0x76, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x13, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x45, 0x78, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x6d, 0x63,
+	0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2d, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_gametracker_grpc_proto_rawDescOnce sync.Once
+	file_gametracker_grpc_proto_rawDescData = file_gametracker_grpc_proto_rawDesc
+)
+
+func file_gametracker_grpc_proto_rawDescGZIP() []byte {
+	file_gametracker_grpc_proto_rawDescOnce.Do(func() {
+		file_gametracker_grpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_gametracker_grpc_proto_rawDescData)
+	})
+	return file_gametracker_grpc_proto_rawDescData
+}
+
+var file_gametracker_grpc_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_gametracker_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_gametracker_grpc_proto_goTypes = []any{
+	(GameOutcome)(0),                               // 0: emortal.gametracker.grpc.GameOutcome
+	(LeaderboardMetric)(0),                         // 1: emortal.gametracker.grpc.LeaderboardMetric
+	(LeaderboardWindow)(0),                         // 2: emortal.gametracker.grpc.LeaderboardWindow
+	(LiveGameEvent_Type)(0),                        // 3: emortal.gametracker.grpc.LiveGameEvent.Type
+	(*LiveGame)(nil),                               // 4: emortal.gametracker.grpc.LiveGame
+	(*HistoricGame)(nil),                           // 5: emortal.gametracker.grpc.HistoricGame
+	(*ListLiveGamesRequest)(nil),                   // 6: emortal.gametracker.grpc.ListLiveGamesRequest
+	(*ListLiveGamesResponse)(nil),                  // 7: emortal.gametracker.grpc.ListLiveGamesResponse
+	(*WatchLiveGamesRequest)(nil),                  // 8: emortal.gametracker.grpc.WatchLiveGamesRequest
+	(*LiveGameEvent)(nil),                          // 9: emortal.gametracker.grpc.LiveGameEvent
+	(*GetHistoricGameRequest)(nil),                 // 10: emortal.gametracker.grpc.GetHistoricGameRequest
+	(*GetHistoricGameResponse)(nil),                // 11: emortal.gametracker.grpc.GetHistoricGameResponse
+	(*GetGameTimelineRequest)(nil),                 // 12: emortal.gametracker.grpc.GetGameTimelineRequest
+	(*GetGameTimelineResponse)(nil),                // 13: emortal.gametracker.grpc.GetGameTimelineResponse
+	(*GetPlayerGameHistoryRequest)(nil),            // 14: emortal.gametracker.grpc.GetPlayerGameHistoryRequest
+	(*GetPlayerGameHistoryResponse)(nil),           // 15: emortal.gametracker.grpc.GetPlayerGameHistoryResponse
+	(*PlayerGameModeStats)(nil),                    // 16: emortal.gametracker.grpc.PlayerGameModeStats
+	(*GetPlayerStatsRequest)(nil),                  // 17: emortal.gametracker.grpc.GetPlayerStatsRequest
+	(*GetPlayerStatsResponse)(nil),                 // 18: emortal.gametracker.grpc.GetPlayerStatsResponse
+	(*TeamColorStats)(nil),                         // 19: emortal.gametracker.grpc.TeamColorStats
+	(*MapStats)(nil),                               // 20: emortal.gametracker.grpc.MapStats
+	(*GetMapStatsRequest)(nil),                     // 21: emortal.gametracker.grpc.GetMapStatsRequest
+	(*GetMapStatsResponse)(nil),                    // 22: emortal.gametracker.grpc.GetMapStatsResponse
+	(*LeaderboardEntry)(nil),                       // 23: emortal.gametracker.grpc.LeaderboardEntry
+	(*GetLeaderboardRequest)(nil),                  // 24: emortal.gametracker.grpc.GetLeaderboardRequest
+	(*GetLeaderboardResponse)(nil),                 // 25: emortal.gametracker.grpc.GetLeaderboardResponse
+	(*GetLeaderboardRankRequest)(nil),              // 26: emortal.gametracker.grpc.GetLeaderboardRankRequest
+	(*GetLeaderboardRankResponse)(nil),             // 27: emortal.gametracker.grpc.GetLeaderboardRankResponse
+	(*TimelineEntry)(nil),                          // 28: emortal.gametracker.grpc.TimelineEntry
+	(*GameTimeline)(nil),                           // 29: emortal.gametracker.grpc.GameTimeline
+	(*Review)(nil),                                 // 30: emortal.gametracker.grpc.Review
+	(*ListReviewsRequest)(nil),                     // 31: emortal.gametracker.grpc.ListReviewsRequest
+	(*ListReviewsResponse)(nil),                    // 32: emortal.gametracker.grpc.ListReviewsResponse
+	(*ResolveReviewRequest)(nil),                   // 33: emortal.gametracker.grpc.ResolveReviewRequest
+	(*ResolveReviewResponse)(nil),                  // 34: emortal.gametracker.grpc.ResolveReviewResponse
+	nil,                                            // 35: emortal.gametracker.grpc.PlayerGameModeStats.CountersEntry
+	(*timestamppb.Timestamp)(nil),                  // 36: google.protobuf.Timestamp
+	(*gametracker.BasicGamePlayer)(nil),            // 37: emortal.model.game_tracker.BasicGamePlayer
+	(*gametracker.Team)(nil),                       // 38: emortal.model.game_tracker.Team
+	(*anypb.Any)(nil),                              // 39: google.protobuf.Any
+	(*gametracker.CommonGameFinishWinnerData)(nil), // 40: emortal.model.game_tracker.CommonGameFinishWinnerData
+	(*durationpb.Duration)(nil),                    // 41: google.protobuf.Duration
+}
+var file_gametracker_grpc_proto_depIdxs = []int32{
+	36, // 0: emortal.gametracker.grpc.LiveGame.start_time:type_name -> google.protobuf.Timestamp
+	36, // 1: emortal.gametracker.grpc.LiveGame.last_updated:type_name -> google.protobuf.Timestamp
+	37, // 2: emortal.gametracker.grpc.LiveGame.players:type_name -> emortal.model.game_tracker.BasicGamePlayer
+	38, // 3: emortal.gametracker.grpc.LiveGame.teams:type_name -> emortal.model.game_tracker.Team
+	39, // 4: emortal.gametracker.grpc.LiveGame.game_data:type_name -> google.protobuf.Any
+	39, // 5: emortal.gametracker.grpc.LiveGame.raw_content:type_name -> google.protobuf.Any
+	36, // 6: emortal.gametracker.grpc.HistoricGame.start_time:type_name -> google.protobuf.Timestamp
+	36, // 7: emortal.gametracker.grpc.HistoricGame.end_time:type_name -> google.protobuf.Timestamp
+	37, // 8: emortal.gametracker.grpc.HistoricGame.players:type_name -> emortal.model.game_tracker.BasicGamePlayer
+	38, // 9: emortal.gametracker.grpc.HistoricGame.teams:type_name -> emortal.model.game_tracker.Team
+	40, // 10: emortal.gametracker.grpc.HistoricGame.winner_data:type_name -> emortal.model.game_tracker.CommonGameFinishWinnerData
+	39, // 11: emortal.gametracker.grpc.HistoricGame.game_data:type_name -> google.protobuf.Any
+	0,  // 12: emortal.gametracker.grpc.HistoricGame.outcome:type_name -> emortal.gametracker.grpc.GameOutcome
+	39, // 13: emortal.gametracker.grpc.HistoricGame.raw_content:type_name -> google.protobuf.Any
+	36, // 14: emortal.gametracker.grpc.HistoricGame.summarized_at:type_name -> google.protobuf.Timestamp
+	4,  // 15: emortal.gametracker.grpc.ListLiveGamesResponse.games:type_name -> emortal.gametracker.grpc.LiveGame
+	3,  // 16: emortal.gametracker.grpc.LiveGameEvent.type:type_name -> emortal.gametracker.grpc.LiveGameEvent.Type
+	4,  // 17: emortal.gametracker.grpc.LiveGameEvent.game:type_name -> emortal.gametracker.grpc.LiveGame
+	41, // 18: emortal.gametracker.grpc.LiveGameEvent.elapsed:type_name -> google.protobuf.Duration
+	5,  // 19: emortal.gametracker.grpc.GetHistoricGameResponse.game:type_name -> emortal.gametracker.grpc.HistoricGame
+	29, // 20: emortal.gametracker.grpc.GetGameTimelineResponse.timeline:type_name -> emortal.gametracker.grpc.GameTimeline
+	36, // 21: emortal.gametracker.grpc.GetPlayerGameHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	36, // 22: emortal.gametracker.grpc.GetPlayerGameHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	5,  // 23: emortal.gametracker.grpc.GetPlayerGameHistoryResponse.games:type_name -> emortal.gametracker.grpc.HistoricGame
+	41, // 24: emortal.gametracker.grpc.PlayerGameModeStats.playtime:type_name -> google.protobuf.Duration
+	35, // 25: emortal.gametracker.grpc.PlayerGameModeStats.counters:type_name -> emortal.gametracker.grpc.PlayerGameModeStats.CountersEntry
+	16, // 26: emortal.gametracker.grpc.GetPlayerStatsResponse.stats:type_name -> emortal.gametracker.grpc.PlayerGameModeStats
+	41, // 27: emortal.gametracker.grpc.MapStats.average_duration:type_name -> google.protobuf.Duration
+	19, // 28: emortal.gametracker.grpc.MapStats.team_colors:type_name -> emortal.gametracker.grpc.TeamColorStats
+	20, // 29: emortal.gametracker.grpc.GetMapStatsResponse.maps:type_name -> emortal.gametracker.grpc.MapStats
+	1,  // 30: emortal.gametracker.grpc.GetLeaderboardRequest.metric:type_name -> emortal.gametracker.grpc.LeaderboardMetric
+	2,  // 31: emortal.gametracker.grpc.GetLeaderboardRequest.window:type_name -> emortal.gametracker.grpc.LeaderboardWindow
+	23, // 32: emortal.gametracker.grpc.GetLeaderboardResponse.entries:type_name -> emortal.gametracker.grpc.LeaderboardEntry
+	36, // 33: emortal.gametracker.grpc.GetLeaderboardResponse.period_start:type_name -> google.protobuf.Timestamp
+	36, // 34: emortal.gametracker.grpc.GetLeaderboardResponse.period_end:type_name -> google.protobuf.Timestamp
+	1,  // 35: emortal.gametracker.grpc.GetLeaderboardRankRequest.metric:type_name -> emortal.gametracker.grpc.LeaderboardMetric
+	2,  // 36: emortal.gametracker.grpc.GetLeaderboardRankRequest.window:type_name -> emortal.gametracker.grpc.LeaderboardWindow
+	23, // 37: emortal.gametracker.grpc.GetLeaderboardRankResponse.entry:type_name -> emortal.gametracker.grpc.LeaderboardEntry
+	36, // 38: emortal.gametracker.grpc.TimelineEntry.time:type_name -> google.protobuf.Timestamp
+	38, // 39: emortal.gametracker.grpc.TimelineEntry.teams:type_name -> emortal.model.game_tracker.Team
+	39, // 40: emortal.gametracker.grpc.TimelineEntry.game_data:type_name -> google.protobuf.Any
+	28, // 41: emortal.gametracker.grpc.GameTimeline.entries:type_name -> emortal.gametracker.grpc.TimelineEntry
+	36, // 42: emortal.gametracker.grpc.Review.created_at:type_name -> google.protobuf.Timestamp
+	36, // 43: emortal.gametracker.grpc.Review.resolved_at:type_name -> google.protobuf.Timestamp
+	30, // 44: emortal.gametracker.grpc.ListReviewsResponse.reviews:type_name -> emortal.gametracker.grpc.Review
+	30, // 45: emortal.gametracker.grpc.ResolveReviewResponse.review:type_name -> emortal.gametracker.grpc.Review
+	6,  // 46: emortal.gametracker.grpc.GameQuery.ListLiveGames:input_type -> emortal.gametracker.grpc.ListLiveGamesRequest
+	10, // 47: emortal.gametracker.grpc.GameQuery.GetHistoricGame:input_type -> emortal.gametracker.grpc.GetHistoricGameRequest
+	14, // 48: emortal.gametracker.grpc.GameQuery.GetPlayerGameHistory:input_type -> emortal.gametracker.grpc.GetPlayerGameHistoryRequest
+	12, // 49: emortal.gametracker.grpc.GameQuery.GetGameTimeline:input_type -> emortal.gametracker.grpc.GetGameTimelineRequest
+	8,  // 50: emortal.gametracker.grpc.GameQuery.WatchLiveGames:input_type -> emortal.gametracker.grpc.WatchLiveGamesRequest
+	17, // 51: emortal.gametracker.grpc.PlayerStats.GetPlayerStats:input_type -> emortal.gametracker.grpc.GetPlayerStatsRequest
+	21, // 52: emortal.gametracker.grpc.MapAnalytics.GetMapStats:input_type -> emortal.gametracker.grpc.GetMapStatsRequest
+	31, // 53: emortal.gametracker.grpc.ReviewQueue.ListReviews:input_type -> emortal.gametracker.grpc.ListReviewsRequest
+	33, // 54: emortal.gametracker.grpc.ReviewQueue.ResolveReview:input_type -> emortal.gametracker.grpc.ResolveReviewRequest
+	24, // 55: emortal.gametracker.grpc.Leaderboard.GetLeaderboard:input_type -> emortal.gametracker.grpc.GetLeaderboardRequest
+	26, // 56: emortal.gametracker.grpc.Leaderboard.GetLeaderboardRank:input_type -> emortal.gametracker.grpc.GetLeaderboardRankRequest
+	7,  // 57: emortal.gametracker.grpc.GameQuery.ListLiveGames:output_type -> emortal.gametracker.grpc.ListLiveGamesResponse
+	11, // 58: emortal.gametracker.grpc.GameQuery.GetHistoricGame:output_type -> emortal.gametracker.grpc.GetHistoricGameResponse
+	15, // 59: emortal.gametracker.grpc.GameQuery.GetPlayerGameHistory:output_type -> emortal.gametracker.grpc.GetPlayerGameHistoryResponse
+	13, // 60: emortal.gametracker.grpc.GameQuery.GetGameTimeline:output_type -> emortal.gametracker.grpc.GetGameTimelineResponse
+	9,  // 61: emortal.gametracker.grpc.GameQuery.WatchLiveGames:output_type -> emortal.gametracker.grpc.LiveGameEvent
+	18, // 62: emortal.gametracker.grpc.PlayerStats.GetPlayerStats:output_type -> emortal.gametracker.grpc.GetPlayerStatsResponse
+	22, // 63: emortal.gametracker.grpc.MapAnalytics.GetMapStats:output_type -> emortal.gametracker.grpc.GetMapStatsResponse
+	32, // 64: emortal.gametracker.grpc.ReviewQueue.ListReviews:output_type -> emortal.gametracker.grpc.ListReviewsResponse
+	34, // 65: emortal.gametracker.grpc.ReviewQueue.ResolveReview:output_type -> emortal.gametracker.grpc.ResolveReviewResponse
+	25, // 66: emortal.gametracker.grpc.Leaderboard.GetLeaderboard:output_type -> emortal.gametracker.grpc.GetLeaderboardResponse
+	27, // 67: emortal.gametracker.grpc.Leaderboard.GetLeaderboardRank:output_type -> emortal.gametracker.grpc.GetLeaderboardRankResponse
+	57, // [57:68] is the sub-list for method output_type
+	46, // [46:57] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
+}
+
+func init() { file_gametracker_grpc_proto_init() }
+func file_gametracker_grpc_proto_init() {
+	if File_gametracker_grpc_proto != nil {
+		return
+	}
+	file_gametracker_grpc_proto_msgTypes[0].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[1].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[2].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[4].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[5].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[10].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[11].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[13].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[17].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[21].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[23].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[24].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[26].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[27].OneofWrappers = []any{}
+	file_gametracker_grpc_proto_msgTypes[28].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gametracker_grpc_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   5,
+		},
+		GoTypes:           file_gametracker_grpc_proto_goTypes,
+		DependencyIndexes: file_gametracker_grpc_proto_depIdxs,
+		EnumInfos:         file_gametracker_grpc_proto_enumTypes,
+		MessageInfos:      file_gametracker_grpc_proto_msgTypes,
+	}.Build()
+	File_gametracker_grpc_proto = out.File
+	file_gametracker_grpc_proto_rawDesc = nil
+	file_gametracker_grpc_proto_goTypes = nil
+	file_gametracker_grpc_proto_depIdxs = nil
+}
@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.28.0
+// source: gametracker/grpc.proto
+
+package gametrackerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GameQueryClient is the client API for GameQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameQueryClient interface {
+	// ListLiveGames returns every game that is currently running, optionally filtered by game mode or server.
+	ListLiveGames(ctx context.Context, in *ListLiveGamesRequest, opts ...grpc.CallOption) (*ListLiveGamesResponse, error)
+	// GetHistoricGame returns a finished game by its id.
+	GetHistoricGame(ctx context.Context, in *GetHistoricGameRequest, opts ...grpc.CallOption) (*GetHistoricGameResponse, error)
+	// GetPlayerGameHistory pages through the finished games of a player, newest first.
+	GetPlayerGameHistory(ctx context.Context, in *GetPlayerGameHistoryRequest, opts ...grpc.CallOption) (*GetPlayerGameHistoryResponse, error)
+	// GetGameTimeline returns how a live or historic game changed over time. Timelines are only recorded if enabled.
+	GetGameTimeline(ctx context.Context, in *GetGameTimelineRequest, opts ...grpc.CallOption) (*GetGameTimelineResponse, error)
+	// WatchLiveGames streams the live games matching the filter. Every matching game is first sent as ADDED,
+	// then changes are sent as games start, update and finish. A game that stops matching is sent as REMOVED.
+	// The stream ends with RESOURCE_EXHAUSTED if the client falls too far behind, and should be reopened. It fails with
+	// UNAVAILABLE while the live games are still being loaded after startup.
+	WatchLiveGames(ctx context.Context, in *WatchLiveGamesRequest, opts ...grpc.CallOption) (GameQuery_WatchLiveGamesClient, error)
+}
+
+type gameQueryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameQueryClient(cc grpc.ClientConnInterface) GameQueryClient {
+	return &gameQueryClient{cc}
+}
+
+func (c *gameQueryClient) ListLiveGames(ctx context.Context, in *ListLiveGamesRequest, opts ...grpc.CallOption) (*ListLiveGamesResponse, error) {
+	out := new(ListLiveGamesResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.GameQuery/ListLiveGames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameQueryClient) GetHistoricGame(ctx context.Context, in *GetHistoricGameRequest, opts ...grpc.CallOption) (*GetHistoricGameResponse, error) {
+	out := new(GetHistoricGameResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.GameQuery/GetHistoricGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameQueryClient) GetPlayerGameHistory(ctx context.Context, in *GetPlayerGameHistoryRequest, opts ...grpc.CallOption) (*GetPlayerGameHistoryResponse, error) {
+	out := new(GetPlayerGameHistoryResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.GameQuery/GetPlayerGameHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameQueryClient) GetGameTimeline(ctx context.Context, in *GetGameTimelineRequest, opts ...grpc.CallOption) (*GetGameTimelineResponse, error) {
+	out := new(GetGameTimelineResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.GameQuery/GetGameTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameQueryClient) WatchLiveGames(ctx context.Context, in *WatchLiveGamesRequest, opts ...grpc.CallOption) (GameQuery_WatchLiveGamesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GameQuery_ServiceDesc.Streams[0], "/emortal.gametracker.grpc.GameQuery/WatchLiveGames", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gameQueryWatchLiveGamesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GameQuery_WatchLiveGamesClient interface {
+	Recv() (*LiveGameEvent, error)
+	grpc.ClientStream
+}
+
+type gameQueryWatchLiveGamesClient struct {
+	grpc.ClientStream
+}
+
+func (x *gameQueryWatchLiveGamesClient) Recv() (*LiveGameEvent, error) {
+	m := new(LiveGameEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GameQueryServer is the server API for GameQuery service.
+// All implementations must embed UnimplementedGameQueryServer
+// for forward compatibility
+type GameQueryServer interface {
+	// ListLiveGames returns every game that is currently running, optionally filtered by game mode or server.
+	ListLiveGames(context.Context, *ListLiveGamesRequest) (*ListLiveGamesResponse, error)
+	// GetHistoricGame returns a finished game by its id.
+	GetHistoricGame(context.Context, *GetHistoricGameRequest) (*GetHistoricGameResponse, error)
+	// GetPlayerGameHistory pages through the finished games of a player, newest first.
+	GetPlayerGameHistory(context.Context, *GetPlayerGameHistoryRequest) (*GetPlayerGameHistoryResponse, error)
+	// GetGameTimeline returns how a live or historic game changed over time. Timelines are only recorded if enabled.
+	GetGameTimeline(context.Context, *GetGameTimelineRequest) (*GetGameTimelineResponse, error)
+	// WatchLiveGames streams the live games matching the filter. Every matching game is first sent as ADDED,
+	// then changes are sent as games start, update and finish. A game that stops matching is sent as REMOVED.
+	// The stream ends with RESOURCE_EXHAUSTED if the client falls too far behind, and should be reopened. It fails with
+	// UNAVAILABLE while the live games are still being loaded after startup.
+	WatchLiveGames(*WatchLiveGamesRequest, GameQuery_WatchLiveGamesServer) error
+	mustEmbedUnimplementedGameQueryServer()
+}
+
+// UnimplementedGameQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedGameQueryServer struct {
+}
+
+func (UnimplementedGameQueryServer) ListLiveGames(context.Context, *ListLiveGamesRequest) (*ListLiveGamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLiveGames not implemented")
+}
+func (UnimplementedGameQueryServer) GetHistoricGame(context.Context, *GetHistoricGameRequest) (*GetHistoricGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricGame not implemented")
+}
+func (UnimplementedGameQueryServer) GetPlayerGameHistory(context.Context, *GetPlayerGameHistoryRequest) (*GetPlayerGameHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerGameHistory not implemented")
+}
+func (UnimplementedGameQueryServer) GetGameTimeline(context.Context, *GetGameTimelineRequest) (*GetGameTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameTimeline not implemented")
+}
+func (UnimplementedGameQueryServer) WatchLiveGames(*WatchLiveGamesRequest, GameQuery_WatchLiveGamesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLiveGames not implemented")
+}
+func (UnimplementedGameQueryServer) mustEmbedUnimplementedGameQueryServer() {}
+
+// UnsafeGameQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameQueryServer will
+// result in compilation errors.
+type UnsafeGameQueryServer interface {
+	mustEmbedUnimplementedGameQueryServer()
+}
+
+func RegisterGameQueryServer(s grpc.ServiceRegistrar, srv GameQueryServer) {
+	s.RegisterService(&GameQuery_ServiceDesc, srv)
+}
+
+func _GameQuery_ListLiveGames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLiveGamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameQueryServer).ListLiveGames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.GameQuery/ListLiveGames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameQueryServer).ListLiveGames(ctx, req.(*ListLiveGamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameQuery_GetHistoricGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameQueryServer).GetHistoricGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.GameQuery/GetHistoricGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameQueryServer).GetHistoricGame(ctx, req.(*GetHistoricGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameQuery_GetPlayerGameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerGameHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameQueryServer).GetPlayerGameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.GameQuery/GetPlayerGameHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameQueryServer).GetPlayerGameHistory(ctx, req.(*GetPlayerGameHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameQuery_GetGameTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameQueryServer).GetGameTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.GameQuery/GetGameTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameQueryServer).GetGameTimeline(ctx, req.(*GetGameTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameQuery_WatchLiveGames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLiveGamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameQueryServer).WatchLiveGames(m, &gameQueryWatchLiveGamesServer{stream})
+}
+
+type GameQuery_WatchLiveGamesServer interface {
+	Send(*LiveGameEvent) error
+	grpc.ServerStream
+}
+
+type gameQueryWatchLiveGamesServer struct {
+	grpc.ServerStream
+}
+
+func (x *gameQueryWatchLiveGamesServer) Send(m *LiveGameEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// GameQuery_ServiceDesc is the grpc.ServiceDesc for GameQuery service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameQuery_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.gametracker.grpc.GameQuery",
+	HandlerType: (*GameQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListLiveGames",
+			Handler:    _GameQuery_ListLiveGames_Handler,
+		},
+		{
+			MethodName: "GetHistoricGame",
+			Handler:    _GameQuery_GetHistoricGame_Handler,
+		},
+		{
+			MethodName: "GetPlayerGameHistory",
+			Handler:    _GameQuery_GetPlayerGameHistory_Handler,
+		},
+		{
+			MethodName: "GetGameTimeline",
+			Handler:    _GameQuery_GetGameTimeline_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLiveGames",
+			Handler:       _GameQuery_WatchLiveGames_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "gametracker/grpc.proto",
+}
+
+// PlayerStatsClient is the client API for PlayerStats service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlayerStatsClient interface {
+	// GetPlayerStats returns the totals of a player for every game mode they have played, or a single game mode.
+	GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*GetPlayerStatsResponse, error)
+}
+
+type playerStatsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlayerStatsClient(cc grpc.ClientConnInterface) PlayerStatsClient {
+	return &playerStatsClient{cc}
+}
+
+func (c *playerStatsClient) GetPlayerStats(ctx context.Context, in *GetPlayerStatsRequest, opts ...grpc.CallOption) (*GetPlayerStatsResponse, error) {
+	out := new(GetPlayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.PlayerStats/GetPlayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlayerStatsServer is the server API for PlayerStats service.
+// All implementations must embed UnimplementedPlayerStatsServer
+// for forward compatibility
+type PlayerStatsServer interface {
+	// GetPlayerStats returns the totals of a player for every game mode they have played, or a single game mode.
+	GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*GetPlayerStatsResponse, error)
+	mustEmbedUnimplementedPlayerStatsServer()
+}
+
+// UnimplementedPlayerStatsServer must be embedded to have forward compatible implementations.
+type UnimplementedPlayerStatsServer struct {
+}
+
+func (UnimplementedPlayerStatsServer) GetPlayerStats(context.Context, *GetPlayerStatsRequest) (*GetPlayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerStats not implemented")
+}
+func (UnimplementedPlayerStatsServer) mustEmbedUnimplementedPlayerStatsServer() {}
+
+// UnsafePlayerStatsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlayerStatsServer will
+// result in compilation errors.
+type UnsafePlayerStatsServer interface {
+	mustEmbedUnimplementedPlayerStatsServer()
+}
+
+func RegisterPlayerStatsServer(s grpc.ServiceRegistrar, srv PlayerStatsServer) {
+	s.RegisterService(&PlayerStats_ServiceDesc, srv)
+}
+
+func _PlayerStats_GetPlayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerStatsServer).GetPlayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.PlayerStats/GetPlayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerStatsServer).GetPlayerStats(ctx, req.(*GetPlayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlayerStats_ServiceDesc is the grpc.ServiceDesc for PlayerStats service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlayerStats_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.gametracker.grpc.PlayerStats",
+	HandlerType: (*PlayerStatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPlayerStats",
+			Handler:    _PlayerStats_GetPlayerStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gametracker/grpc.proto",
+}
+
+// MapAnalyticsClient is the client API for MapAnalytics service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MapAnalyticsClient interface {
+	// GetMapStats returns the totals of every map of a game mode, or a single map. Private games aren't counted.
+	GetMapStats(ctx context.Context, in *GetMapStatsRequest, opts ...grpc.CallOption) (*GetMapStatsResponse, error)
+}
+
+type mapAnalyticsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMapAnalyticsClient(cc grpc.ClientConnInterface) MapAnalyticsClient {
+	return &mapAnalyticsClient{cc}
+}
+
+func (c *mapAnalyticsClient) GetMapStats(ctx context.Context, in *GetMapStatsRequest, opts ...grpc.CallOption) (*GetMapStatsResponse, error) {
+	out := new(GetMapStatsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.MapAnalytics/GetMapStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MapAnalyticsServer is the server API for MapAnalytics service.
+// All implementations must embed UnimplementedMapAnalyticsServer
+// for forward compatibility
+type MapAnalyticsServer interface {
+	// GetMapStats returns the totals of every map of a game mode, or a single map. Private games aren't counted.
+	GetMapStats(context.Context, *GetMapStatsRequest) (*GetMapStatsResponse, error)
+	mustEmbedUnimplementedMapAnalyticsServer()
+}
+
+// UnimplementedMapAnalyticsServer must be embedded to have forward compatible implementations.
+type UnimplementedMapAnalyticsServer struct {
+}
+
+func (UnimplementedMapAnalyticsServer) GetMapStats(context.Context, *GetMapStatsRequest) (*GetMapStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMapStats not implemented")
+}
+func (UnimplementedMapAnalyticsServer) mustEmbedUnimplementedMapAnalyticsServer() {}
+
+// UnsafeMapAnalyticsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MapAnalyticsServer will
+// result in compilation errors.
+type UnsafeMapAnalyticsServer interface {
+	mustEmbedUnimplementedMapAnalyticsServer()
+}
+
+func RegisterMapAnalyticsServer(s grpc.ServiceRegistrar, srv MapAnalyticsServer) {
+	s.RegisterService(&MapAnalytics_ServiceDesc, srv)
+}
+
+func _MapAnalytics_GetMapStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMapStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MapAnalyticsServer).GetMapStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.MapAnalytics/GetMapStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MapAnalyticsServer).GetMapStats(ctx, req.(*GetMapStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MapAnalytics_ServiceDesc is the grpc.ServiceDesc for MapAnalytics service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MapAnalytics_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.gametracker.grpc.MapAnalytics",
+	HandlerType: (*MapAnalyticsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMapStats",
+			Handler:    _MapAnalytics_GetMapStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gametracker/grpc.proto",
+}
+
+// ReviewQueueClient is the client API for ReviewQueue service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewQueueClient interface {
+	// ListReviews pages through the games and players flagged by anomaly checks, newest first.
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// ResolveReview marks a review as looked at. Resolving a player's review of their rolling stats lets the same check
+	// flag them again.
+	ResolveReview(ctx context.Context, in *ResolveReviewRequest, opts ...grpc.CallOption) (*ResolveReviewResponse, error)
+}
+
+type reviewQueueClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewQueueClient(cc grpc.ClientConnInterface) ReviewQueueClient {
+	return &reviewQueueClient{cc}
+}
+
+func (c *reviewQueueClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.ReviewQueue/ListReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewQueueClient) ResolveReview(ctx context.Context, in *ResolveReviewRequest, opts ...grpc.CallOption) (*ResolveReviewResponse, error) {
+	out := new(ResolveReviewResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.ReviewQueue/ResolveReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewQueueServer is the server API for ReviewQueue service.
+// All implementations must embed UnimplementedReviewQueueServer
+// for forward compatibility
+type ReviewQueueServer interface {
+	// ListReviews pages through the games and players flagged by anomaly checks, newest first.
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	// ResolveReview marks a review as looked at. Resolving a player's review of their rolling stats lets the same check
+	// flag them again.
+	ResolveReview(context.Context, *ResolveReviewRequest) (*ResolveReviewResponse, error)
+	mustEmbedUnimplementedReviewQueueServer()
+}
+
+// UnimplementedReviewQueueServer must be embedded to have forward compatible implementations.
+type UnimplementedReviewQueueServer struct {
+}
+
+func (UnimplementedReviewQueueServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedReviewQueueServer) ResolveReview(context.Context, *ResolveReviewRequest) (*ResolveReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReview not implemented")
+}
+func (UnimplementedReviewQueueServer) mustEmbedUnimplementedReviewQueueServer() {}
+
+// UnsafeReviewQueueServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewQueueServer will
+// result in compilation errors.
+type UnsafeReviewQueueServer interface {
+	mustEmbedUnimplementedReviewQueueServer()
+}
+
+func RegisterReviewQueueServer(s grpc.ServiceRegistrar, srv ReviewQueueServer) {
+	s.RegisterService(&ReviewQueue_ServiceDesc, srv)
+}
+
+func _ReviewQueue_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewQueueServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.ReviewQueue/ListReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewQueueServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewQueue_ResolveReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewQueueServer).ResolveReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.ReviewQueue/ResolveReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewQueueServer).ResolveReview(ctx, req.(*ResolveReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewQueue_ServiceDesc is the grpc.ServiceDesc for ReviewQueue service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewQueue_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.gametracker.grpc.ReviewQueue",
+	HandlerType: (*ReviewQueueServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListReviews",
+			Handler:    _ReviewQueue_ListReviews_Handler,
+		},
+		{
+			MethodName: "ResolveReview",
+			Handler:    _ReviewQueue_ResolveReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gametracker/grpc.proto",
+}
+
+// LeaderboardClient is the client API for Leaderboard service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LeaderboardClient interface {
+	// GetLeaderboard returns the top players of a game mode for a metric and time window.
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	// GetLeaderboardRank returns the rank of a single player on a leaderboard.
+	GetLeaderboardRank(ctx context.Context, in *GetLeaderboardRankRequest, opts ...grpc.CallOption) (*GetLeaderboardRankResponse, error)
+}
+
+type leaderboardClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeaderboardClient(cc grpc.ClientConnInterface) LeaderboardClient {
+	return &leaderboardClient{cc}
+}
+
+func (c *leaderboardClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.Leaderboard/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderboardClient) GetLeaderboardRank(ctx context.Context, in *GetLeaderboardRankRequest, opts ...grpc.CallOption) (*GetLeaderboardRankResponse, error) {
+	out := new(GetLeaderboardRankResponse)
+	err := c.cc.Invoke(ctx, "/emortal.gametracker.grpc.Leaderboard/GetLeaderboardRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LeaderboardServer is the server API for Leaderboard service.
+// All implementations must embed UnimplementedLeaderboardServer
+// for forward compatibility
+type LeaderboardServer interface {
+	// GetLeaderboard returns the top players of a game mode for a metric and time window.
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	// GetLeaderboardRank returns the rank of a single player on a leaderboard.
+	GetLeaderboardRank(context.Context, *GetLeaderboardRankRequest) (*GetLeaderboardRankResponse, error)
+	mustEmbedUnimplementedLeaderboardServer()
+}
+
+// UnimplementedLeaderboardServer must be embedded to have forward compatible implementations.
+type UnimplementedLeaderboardServer struct {
+}
+
+func (UnimplementedLeaderboardServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedLeaderboardServer) GetLeaderboardRank(context.Context, *GetLeaderboardRankRequest) (*GetLeaderboardRankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboardRank not implemented")
+}
+func (UnimplementedLeaderboardServer) mustEmbedUnimplementedLeaderboardServer() {}
+
+// UnsafeLeaderboardServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LeaderboardServer will
+// result in compilation errors.
+type UnsafeLeaderboardServer interface {
+	mustEmbedUnimplementedLeaderboardServer()
+}
+
+func RegisterLeaderboardServer(s grpc.ServiceRegistrar, srv LeaderboardServer) {
+	s.RegisterService(&Leaderboard_ServiceDesc, srv)
+}
+
+func _Leaderboard_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.Leaderboard/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leaderboard_GetLeaderboardRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderboardServer).GetLeaderboardRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.gametracker.grpc.Leaderboard/GetLeaderboardRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderboardServer).GetLeaderboardRank(ctx, req.(*GetLeaderboardRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Leaderboard_ServiceDesc is the grpc.ServiceDesc for Leaderboard service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Leaderboard_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.gametracker.grpc.Leaderboard",
+	HandlerType: (*LeaderboardServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _Leaderboard_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetLeaderboardRank",
+			Handler:    _Leaderboard_GetLeaderboardRank_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gametracker/grpc.proto",
+}
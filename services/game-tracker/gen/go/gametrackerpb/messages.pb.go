@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.0
+// source: gametracker/messages.proto
+
+package gametrackerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AchievementUnlockedMessage is sent when a player unlocks an achievement by finishing a game.
+// Each player unlocks an achievement once.
+type AchievementUnlockedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId      string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AchievementId string `protobuf:"bytes,2,opt,name=achievement_id,json=achievementId,proto3" json:"achievement_id,omitempty"`
+	GameModeId    string `protobuf:"bytes,3,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	// game_id is the game that unlocked the achievement
+	GameId string `protobuf:"bytes,4,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// badge_id is the badge granted for the achievement, if any
+	BadgeId    *string                `protobuf:"bytes,5,opt,name=badge_id,json=badgeId,proto3,oneof" json:"badge_id,omitempty"`
+	UnlockedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+}
+
+func (x *AchievementUnlockedMessage) Reset() {
+	*x = AchievementUnlockedMessage{}
+	mi := &file_gametracker_messages_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AchievementUnlockedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementUnlockedMessage) ProtoMessage() {}
+
+func (x *AchievementUnlockedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_messages_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementUnlockedMessage.ProtoReflect.Descriptor instead.
+func (*AchievementUnlockedMessage) Descriptor() ([]byte, []int) {
+	return file_gametracker_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AchievementUnlockedMessage) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedMessage) GetAchievementId() string {
+	if x != nil {
+		return x.AchievementId
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedMessage) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedMessage) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedMessage) GetBadgeId() string {
+	if x != nil && x.BadgeId != nil {
+		return *x.BadgeId
+	}
+	return ""
+}
+
+func (x *AchievementUnlockedMessage) GetUnlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return nil
+}
+
+// ReviewCreatedMessage is sent when an anomaly check flags a game or player for staff to review
+type ReviewCreatedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review *Review `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *ReviewCreatedMessage) Reset() {
+	*x = ReviewCreatedMessage{}
+	mi := &file_gametracker_messages_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCreatedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCreatedMessage) ProtoMessage() {}
+
+func (x *ReviewCreatedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_messages_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCreatedMessage.ProtoReflect.Descriptor instead.
+func (*ReviewCreatedMessage) Descriptor() ([]byte, []int) {
+	return file_gametracker_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewCreatedMessage) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+var File_gametracker_messages_proto protoreflect.FileDescriptor
+
+var file_gametracker_messages_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x65, 0x6d,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x67, 0x61, 0x6d, 0x65,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x85, 0x02, 0x0a, 0x1a, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x08, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x62, 0x61, 0x64, 0x67, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x62, 0x61, 0x64, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x14, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x90, 0x01, 0x0a,
+	0x23, 0x64, 0x65, 0x76, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x42, 0x1a, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x45, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x5a, 0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x6d, 0x63, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x67, 0x61,
+	0x6d, 0x65, 0x2d, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x6f, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gametracker_messages_proto_rawDescOnce sync.Once
+	file_gametracker_messages_proto_rawDescData = file_gametracker_messages_proto_rawDesc
+)
+
+func file_gametracker_messages_proto_rawDescGZIP() []byte {
+	file_gametracker_messages_proto_rawDescOnce.Do(func() {
+		file_gametracker_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_gametracker_messages_proto_rawDescData)
+	})
+	return file_gametracker_messages_proto_rawDescData
+}
+
+var file_gametracker_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_gametracker_messages_proto_goTypes = []any{
+	(*AchievementUnlockedMessage)(nil), // 0: emortal.gametracker.message.AchievementUnlockedMessage
+	(*ReviewCreatedMessage)(nil),       // 1: emortal.gametracker.message.ReviewCreatedMessage
+	(*timestamppb.Timestamp)(nil),      // 2: google.protobuf.Timestamp
+	(*Review)(nil),                     // 3: emortal.gametracker.grpc.Review
+}
+var file_gametracker_messages_proto_depIdxs = []int32{
+	2, // 0: emortal.gametracker.message.AchievementUnlockedMessage.unlocked_at:type_name -> google.protobuf.Timestamp
+	3, // 1: emortal.gametracker.message.ReviewCreatedMessage.review:type_name -> emortal.gametracker.grpc.Review
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_gametracker_messages_proto_init() }
+func file_gametracker_messages_proto_init() {
+	if File_gametracker_messages_proto != nil {
+		return
+	}
+	file_gametracker_grpc_proto_init()
+	file_gametracker_messages_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gametracker_messages_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gametracker_messages_proto_goTypes,
+		DependencyIndexes: file_gametracker_messages_proto_depIdxs,
+		MessageInfos:      file_gametracker_messages_proto_msgTypes,
+	}.Build()
+	File_gametracker_messages_proto = out.File
+	file_gametracker_messages_proto_rawDesc = nil
+	file_gametracker_messages_proto_goTypes = nil
+	file_gametracker_messages_proto_depIdxs = nil
+}
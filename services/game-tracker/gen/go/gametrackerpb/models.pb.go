@@ -0,0 +1,478 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.0
+// source: gametracker/models.proto
+
+package gametrackerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CommonGameMetadata describes how a game was created. Games send it in the content of their start message,
+// the game tracker keeps it for the rest of the game.
+// No game sends it yet: it belongs in proto-specs' game_tracker/models.proto next to the game modes' content, and games
+// can only send it once it's published there. Until then match_id and private are never set, and map stats only know
+// the map through the start message's map_id.
+type CommonGameMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// map_id is only needed if the start message's map_id isn't set
+	MapId *string `protobuf:"bytes,1,opt,name=map_id,json=mapId,proto3,oneof" json:"map_id,omitempty"`
+	// match_id is the matchmaker match the game was allocated for, unset for games the matchmaker didn't create
+	MatchId *string `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3,oneof" json:"match_id,omitempty"`
+	// private is true for games of a private lobby rather than a public queue
+	Private bool `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *CommonGameMetadata) Reset() {
+	*x = CommonGameMetadata{}
+	mi := &file_gametracker_models_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommonGameMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommonGameMetadata) ProtoMessage() {}
+
+func (x *CommonGameMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_models_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommonGameMetadata.ProtoReflect.Descriptor instead.
+func (*CommonGameMetadata) Descriptor() ([]byte, []int) {
+	return file_gametracker_models_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CommonGameMetadata) GetMapId() string {
+	if x != nil && x.MapId != nil {
+		return *x.MapId
+	}
+	return ""
+}
+
+func (x *CommonGameMetadata) GetMatchId() string {
+	if x != nil && x.MatchId != nil {
+		return *x.MatchId
+	}
+	return ""
+}
+
+func (x *CommonGameMetadata) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type ParkourTagPlayer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tags is how many players this player tagged as a tagger
+	Tags int32 `protobuf:"varint,1,opt,name=tags,proto3" json:"tags,omitempty"`
+	// tagged_time is when the player was tagged, unset if they haven't been tagged
+	TaggedTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=tagged_time,json=taggedTime,proto3,oneof" json:"tagged_time,omitempty"`
+}
+
+func (x *ParkourTagPlayer) Reset() {
+	*x = ParkourTagPlayer{}
+	mi := &file_gametracker_models_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParkourTagPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParkourTagPlayer) ProtoMessage() {}
+
+func (x *ParkourTagPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_models_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParkourTagPlayer.ProtoReflect.Descriptor instead.
+func (*ParkourTagPlayer) Descriptor() ([]byte, []int) {
+	return file_gametracker_models_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParkourTagPlayer) GetTags() int32 {
+	if x != nil {
+		return x.Tags
+	}
+	return 0
+}
+
+func (x *ParkourTagPlayer) GetTaggedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TaggedTime
+	}
+	return nil
+}
+
+type ParkourTagUpdateData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaggerIds []string `protobuf:"bytes,1,rep,name=tagger_ids,json=taggerIds,proto3" json:"tagger_ids,omitempty"`
+	// players is keyed by player id
+	Players map[string]*ParkourTagPlayer `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ParkourTagUpdateData) Reset() {
+	*x = ParkourTagUpdateData{}
+	mi := &file_gametracker_models_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParkourTagUpdateData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParkourTagUpdateData) ProtoMessage() {}
+
+func (x *ParkourTagUpdateData) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_models_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParkourTagUpdateData.ProtoReflect.Descriptor instead.
+func (*ParkourTagUpdateData) Descriptor() ([]byte, []int) {
+	return file_gametracker_models_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ParkourTagUpdateData) GetTaggerIds() []string {
+	if x != nil {
+		return x.TaggerIds
+	}
+	return nil
+}
+
+func (x *ParkourTagUpdateData) GetPlayers() map[string]*ParkourTagPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// Winners and losers are sent separately with CommonGameFinishWinnerData
+type ParkourTagFinishData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaggerIds []string `protobuf:"bytes,1,rep,name=tagger_ids,json=taggerIds,proto3" json:"tagger_ids,omitempty"`
+	// players is keyed by player id
+	Players map[string]*ParkourTagPlayer `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ParkourTagFinishData) Reset() {
+	*x = ParkourTagFinishData{}
+	mi := &file_gametracker_models_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParkourTagFinishData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParkourTagFinishData) ProtoMessage() {}
+
+func (x *ParkourTagFinishData) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_models_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParkourTagFinishData.ProtoReflect.Descriptor instead.
+func (*ParkourTagFinishData) Descriptor() ([]byte, []int) {
+	return file_gametracker_models_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParkourTagFinishData) GetTaggerIds() []string {
+	if x != nil {
+		return x.TaggerIds
+	}
+	return nil
+}
+
+func (x *ParkourTagFinishData) GetPlayers() map[string]*ParkourTagPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// HistoricMinesweeperData is the final board of a Minesweeper game, returned as the game data of historic games.
+// Minesweeper's finish message has no board, so it's kept from the last update along with the start's totals.
+type HistoricMinesweeperData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalMines     int64 `protobuf:"varint,1,opt,name=total_mines,json=totalMines,proto3" json:"total_mines,omitempty"`
+	TotalBlocks    int64 `protobuf:"varint,2,opt,name=total_blocks,json=totalBlocks,proto3" json:"total_blocks,omitempty"`
+	RemainingMines int32 `protobuf:"varint,3,opt,name=remaining_mines,json=remainingMines,proto3" json:"remaining_mines,omitempty"`
+	PlacedFlags    int32 `protobuf:"varint,4,opt,name=placed_flags,json=placedFlags,proto3" json:"placed_flags,omitempty"`
+	BlocksRevealed int32 `protobuf:"varint,5,opt,name=blocks_revealed,json=blocksRevealed,proto3" json:"blocks_revealed,omitempty"`
+}
+
+func (x *HistoricMinesweeperData) Reset() {
+	*x = HistoricMinesweeperData{}
+	mi := &file_gametracker_models_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoricMinesweeperData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoricMinesweeperData) ProtoMessage() {}
+
+func (x *HistoricMinesweeperData) ProtoReflect() protoreflect.Message {
+	mi := &file_gametracker_models_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoricMinesweeperData.ProtoReflect.Descriptor instead.
+func (*HistoricMinesweeperData) Descriptor() ([]byte, []int) {
+	return file_gametracker_models_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HistoricMinesweeperData) GetTotalMines() int64 {
+	if x != nil {
+		return x.TotalMines
+	}
+	return 0
+}
+
+func (x *HistoricMinesweeperData) GetTotalBlocks() int64 {
+	if x != nil {
+		return x.TotalBlocks
+	}
+	return 0
+}
+
+func (x *HistoricMinesweeperData) GetRemainingMines() int32 {
+	if x != nil {
+		return x.RemainingMines
+	}
+	return 0
+}
+
+func (x *HistoricMinesweeperData) GetPlacedFlags() int32 {
+	if x != nil {
+		return x.PlacedFlags
+	}
+	return 0
+}
+
+func (x *HistoricMinesweeperData) GetBlocksRevealed() int32 {
+	if x != nil {
+		return x.BlocksRevealed
+	}
+	return 0
+}
+
+var File_gametracker_models_proto protoreflect.FileDescriptor
+
+var file_gametracker_models_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x65, 0x6d, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a,
+	0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x22, 0x78, 0x0a, 0x10, 0x50,
+	0x61, 0x72, 0x6b, 0x6f, 0x75, 0x72, 0x54, 0x61, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x74, 0x61, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x61, 0x67, 0x67, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x61, 0x67, 0x67, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x6b, 0x6f, 0x75,
+	0x72, 0x54, 0x61, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x56, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c,
+	0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x6b, 0x6f,
+	0x75, 0x72, 0x54, 0x61, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x67, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x6b, 0x6f, 0x75, 0x72, 0x54, 0x61, 0x67, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf6,
+	0x01, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x6b, 0x6f, 0x75, 0x72, 0x54, 0x61, 0x67, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x67, 0x67, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x67,
+	0x67, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x56, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x6b, 0x6f, 0x75, 0x72, 0x54, 0x61, 0x67, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x1a, 0x67,
+	0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x50, 0x61, 0x72, 0x6b,
+	0x6f, 0x75, 0x72, 0x54, 0x61, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd2, 0x01, 0x0a, 0x17, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x4d, 0x69, 0x6e, 0x65, 0x73, 0x77, 0x65, 0x65, 0x70, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65,
+	0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x42, 0x8c, 0x01, 0x0a,
+	0x21, 0x64, 0x65, 0x76, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x42, 0x18, 0x47, 0x61, 0x6d, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x45,
+	0x78, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x4d, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x6d, 0x63, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2d, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x61,
+	0x6d, 0x65, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gametracker_models_proto_rawDescOnce sync.Once
+	file_gametracker_models_proto_rawDescData = file_gametracker_models_proto_rawDesc
+)
+
+func file_gametracker_models_proto_rawDescGZIP() []byte {
+	file_gametracker_models_proto_rawDescOnce.Do(func() {
+		file_gametracker_models_proto_rawDescData = protoimpl.X.CompressGZIP(file_gametracker_models_proto_rawDescData)
+	})
+	return file_gametracker_models_proto_rawDescData
+}
+
+var file_gametracker_models_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_gametracker_models_proto_goTypes = []any{
+	(*CommonGameMetadata)(nil),      // 0: emortal.gametracker.model.CommonGameMetadata
+	(*ParkourTagPlayer)(nil),        // 1: emortal.gametracker.model.ParkourTagPlayer
+	(*ParkourTagUpdateData)(nil),    // 2: emortal.gametracker.model.ParkourTagUpdateData
+	(*ParkourTagFinishData)(nil),    // 3: emortal.gametracker.model.ParkourTagFinishData
+	(*HistoricMinesweeperData)(nil), // 4: emortal.gametracker.model.HistoricMinesweeperData
+	nil,                             // 5: emortal.gametracker.model.ParkourTagUpdateData.PlayersEntry
+	nil,                             // 6: emortal.gametracker.model.ParkourTagFinishData.PlayersEntry
+	(*timestamppb.Timestamp)(nil),   // 7: google.protobuf.Timestamp
+}
+var file_gametracker_models_proto_depIdxs = []int32{
+	7, // 0: emortal.gametracker.model.ParkourTagPlayer.tagged_time:type_name -> google.protobuf.Timestamp
+	5, // 1: emortal.gametracker.model.ParkourTagUpdateData.players:type_name -> emortal.gametracker.model.ParkourTagUpdateData.PlayersEntry
+	6, // 2: emortal.gametracker.model.ParkourTagFinishData.players:type_name -> emortal.gametracker.model.ParkourTagFinishData.PlayersEntry
+	1, // 3: emortal.gametracker.model.ParkourTagUpdateData.PlayersEntry.value:type_name -> emortal.gametracker.model.ParkourTagPlayer
+	1, // 4: emortal.gametracker.model.ParkourTagFinishData.PlayersEntry.value:type_name -> emortal.gametracker.model.ParkourTagPlayer
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_gametracker_models_proto_init() }
+func file_gametracker_models_proto_init() {
+	if File_gametracker_models_proto != nil {
+		return
+	}
+	file_gametracker_models_proto_msgTypes[0].OneofWrappers = []any{}
+	file_gametracker_models_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gametracker_models_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gametracker_models_proto_goTypes,
+		DependencyIndexes: file_gametracker_models_proto_depIdxs,
+		MessageInfos:      file_gametracker_models_proto_msgTypes,
+	}.Build()
+	File_gametracker_models_proto = out.File
+	file_gametracker_models_proto_rawDesc = nil
+	file_gametracker_models_proto_goTypes = nil
+	file_gametracker_models_proto_depIdxs = nil
+}
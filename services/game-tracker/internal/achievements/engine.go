@@ -0,0 +1,237 @@
+package achievements
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/badge"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// Engine unlocks achievements for finished games
+type Engine interface {
+	// Evaluate unlocks the achievements the players of a finished game met. Player stats must already include the game.
+	// Each player unlocks an achievement at most once, even if called again.
+	// Every player is evaluated even if some fail, the errors are returned together.
+	Evaluate(ctx context.Context, game *model.HistoricGame) error
+	// RetryFailed grants the badges of unlocks whose badge couldn't be granted, see the retry package
+	RetryFailed(ctx context.Context, maxAttempts int, limit int64) error
+}
+
+// Publisher announces unlocked achievements to other services
+type Publisher interface {
+	AchievementUnlocked(ctx context.Context, unlock *model.AchievementUnlock) error
+}
+
+type engineImpl struct {
+	logger    *zap.SugaredLogger
+	repo      repository.Repository
+	badgeSvc  badge.BadgeManagerClient
+	publisher Publisher
+
+	achievementsByMode map[string][]*Achievement
+}
+
+func NewEngine(logger *zap.SugaredLogger, repo repository.Repository, badgeSvc badge.BadgeManagerClient,
+	publisher Publisher, achievements map[string][]*Achievement) Engine {
+
+	return &engineImpl{
+		logger:    logger,
+		repo:      repo,
+		badgeSvc:  badgeSvc,
+		publisher: publisher,
+
+		achievementsByMode: achievements,
+	}
+}
+
+func (e *engineImpl) Evaluate(ctx context.Context, game *model.HistoricGame) error {
+	achievements, ok := e.achievementsByMode[game.GameModeId]
+	if !ok {
+		return nil
+	}
+
+	checksStats := false
+	for _, a := range achievements {
+		if a.Stats != nil {
+			checksStats = true
+			break
+		}
+	}
+
+	winners := make(map[uuid.UUID]bool)
+	if game.WinnerData != nil {
+		for _, id := range game.WinnerData.WinnerIds {
+			winners[id] = true
+		}
+	}
+	values := gameValues(game)
+
+	var errs []error
+
+	// Abandoned games give no stats, so there are none to check
+	for _, gameStats := range model.PlayerStatsFromGame(game) {
+		playerId := gameStats.PlayerId
+
+		var totals *model.PlayerStats
+		if checksStats {
+			var err error
+			totals, err = e.getTotals(ctx, playerId, game.GameModeId)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("player %s: %w", playerId, err))
+				continue
+			}
+		}
+
+		for _, a := range achievements {
+			if a.Game != nil && !a.Game.Met(winners[playerId], values[playerId]) {
+				continue
+			}
+			if a.Stats != nil && !a.Stats.Met(totals) {
+				continue
+			}
+
+			if err := e.unlock(ctx, a, playerId, game); err != nil {
+				errs = append(errs, fmt.Errorf("player %s: %w", playerId, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (e *engineImpl) RetryFailed(ctx context.Context, maxAttempts int, limit int64) error {
+	unlocks, err := e.repo.ListFailedAchievementUnlocks(ctx, maxAttempts, limit)
+	if err != nil {
+		return err
+	}
+
+	for _, unlock := range unlocks {
+		if err := e.grantBadge(ctx, unlock.PlayerId, unlock.BadgeId); err != nil {
+			e.logger.Warnw("failed to retry achievement badge", "playerId", unlock.PlayerId,
+				"achievementId", unlock.AchievementId, "attempts", unlock.Attempts+1, "error", err)
+
+			if setErr := e.repo.SetAchievementUnlockError(ctx, unlock.PlayerId, unlock.AchievementId, err.Error()); setErr != nil {
+				e.logger.Errorw("failed to record achievement unlock error", "playerId", unlock.PlayerId,
+					"achievementId", unlock.AchievementId, "error", setErr)
+			}
+			continue
+		}
+
+		if err := e.repo.ClearAchievementUnlockError(ctx, unlock.PlayerId, unlock.AchievementId); err != nil {
+			e.logger.Errorw("failed to clear achievement unlock error", "playerId", unlock.PlayerId,
+				"achievementId", unlock.AchievementId, "error", err)
+			continue
+		}
+
+		e.logger.Infow("retried achievement badge", "playerId", unlock.PlayerId, "achievementId", unlock.AchievementId)
+	}
+
+	return nil
+}
+
+func (e *engineImpl) getTotals(ctx context.Context, playerId uuid.UUID, gameModeId string) (*model.PlayerStats, error) {
+	stats, err := e.repo.GetPlayerStats(ctx, playerId, &gameModeId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player stats: %w", err)
+	}
+
+	if len(stats) == 0 {
+		return nil, nil
+	}
+	return stats[0], nil
+}
+
+// gameValues returns the values of every player that GameCondition bounds can check
+func gameValues(game *model.HistoricGame) map[uuid.UUID]map[string]int64 {
+	values := make(map[uuid.UUID]map[string]int64, len(game.Players))
+	for _, p := range game.Players {
+		values[p.Id] = make(map[string]int64)
+		if game.StartTime != nil {
+			values[p.Id][valueDurationSeconds] = int64(game.EndTime.Sub(*game.StartTime).Seconds())
+		}
+	}
+
+	add := func(source map[uuid.UUID]map[string]int64) {
+		for id, playerValues := range source {
+			if _, ok := values[id]; !ok {
+				continue
+			}
+
+			for k, v := range playerValues {
+				values[id][k] = v
+			}
+		}
+	}
+
+	if source, ok := game.GameData.(model.PlayerCounterSource); ok {
+		add(source.PlayerCounters())
+	}
+	if source, ok := game.GameData.(model.AchievementValueSource); ok && game.TeamData != nil {
+		add(source.AchievementValues(*game.TeamData))
+	}
+
+	return values
+}
+
+func (e *engineImpl) unlock(ctx context.Context, a *Achievement, playerId uuid.UUID, game *model.HistoricGame) error {
+	unlock := &model.AchievementUnlock{
+		PlayerId:      playerId,
+		AchievementId: a.Id,
+		GameModeId:    game.GameModeId,
+		GameId:        game.Id,
+		BadgeId:       a.BadgeId,
+		UnlockedAt:    time.Now(),
+	}
+
+	// Recorded before granting the badge, see the retry package
+	if err := e.repo.CreateAchievementUnlock(ctx, unlock); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil // Already unlocked
+		}
+
+		return fmt.Errorf("failed to create achievement unlock: %w", err)
+	}
+
+	if a.BadgeId != "" {
+		if err := e.grantBadge(ctx, playerId, a.BadgeId); err != nil {
+			e.logger.Errorw("failed to grant achievement badge", "playerId", playerId, "achievementId", a.Id,
+				"badgeId", a.BadgeId, "error", err)
+
+			if setErr := e.repo.SetAchievementUnlockError(ctx, playerId, a.Id, err.Error()); setErr != nil {
+				e.logger.Errorw("failed to record achievement unlock error", "playerId", playerId,
+					"achievementId", a.Id, "error", setErr)
+			}
+		}
+	}
+
+	// The achievement is unlocked even if the badge couldn't be granted
+	if err := e.publisher.AchievementUnlocked(ctx, unlock); err != nil {
+		e.logger.Errorw("failed to publish achievement unlock", "playerId", playerId, "achievementId", a.Id,
+			"error", err)
+	}
+
+	e.logger.Infow("player unlocked achievement", "playerId", playerId, "achievementId", a.Id,
+		"gameId", game.Id.Hex())
+
+	return nil
+}
+
+func (e *engineImpl) grantBadge(ctx context.Context, playerId uuid.UUID, badgeId string) error {
+	_, err := e.badgeSvc.AddBadgeToPlayer(ctx, &badge.AddBadgeToPlayerRequest{
+		PlayerId: playerId.String(),
+		BadgeId:  badgeId,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		return nil // e.g. the badge was granted manually
+	}
+
+	return err
+}
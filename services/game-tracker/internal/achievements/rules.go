@@ -0,0 +1,177 @@
+package achievements
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/badge"
+	"os"
+	"sort"
+	"time"
+)
+
+// Value names available to GameCondition in addition to the game mode's counters and values
+const (
+	valueDurationSeconds = "durationSeconds"
+)
+
+// Stat names available to StatsCondition in addition to the game mode's counters
+const (
+	statGamesPlayed     = "gamesPlayed"
+	statWins            = "wins"
+	statLosses          = "losses"
+	statPlaytimeMinutes = "playtimeMinutes"
+)
+
+// Achievement is unlocked by a player once a finished game of its game mode meets all of its conditions.
+type Achievement struct {
+	Id         string `json:"id"`
+	GameModeId string `json:"gameModeId"`
+	// BadgeId is granted when the achievement is unlocked, if set
+	BadgeId string `json:"badgeId"`
+
+	// Game checks the game that just finished, Stats the player's totals including that game.
+	// At least one must be set, both must be met if both are set.
+	Game  *GameCondition  `json:"game"`
+	Stats *StatsCondition `json:"stats"`
+}
+
+// GameCondition checks a single game, e.g. "win a Tower Defence game without losing health" is
+// {"won": true, "max": {"healthLost": 0}}.
+type GameCondition struct {
+	Won bool `json:"won"`
+
+	// Min and Max bound the player's values from the game: the game mode's counters (e.g. kills), game mode values
+	// (e.g. healthLost for Tower Defence) and durationSeconds. A value the game doesn't have never meets a bound.
+	Min map[string]int64 `json:"min"`
+	Max map[string]int64 `json:"max"`
+}
+
+// StatsCondition checks the player's totals for the game mode, e.g. "win 100 Block Sumo games" is {"min": {"wins": 100}}.
+type StatsCondition struct {
+	// Min bounds gamesPlayed, wins, losses, playtimeMinutes and the game mode's counters
+	Min map[string]int64 `json:"min"`
+}
+
+func (c *GameCondition) Met(won bool, values map[string]int64) bool {
+	if c.Won && !won {
+		return false
+	}
+
+	return meetsBounds(values, c.Min, c.Max)
+}
+
+func (c *StatsCondition) Met(stats *model.PlayerStats) bool {
+	if stats == nil {
+		return false
+	}
+
+	values := map[string]int64{
+		statGamesPlayed:     stats.GamesPlayed,
+		statWins:            stats.Wins,
+		statLosses:          stats.Losses,
+		statPlaytimeMinutes: int64(stats.Playtime.Minutes()),
+	}
+	for k, v := range stats.Counters {
+		values[k] = v
+	}
+
+	return meetsBounds(values, c.Min, nil)
+}
+
+func meetsBounds(values map[string]int64, min map[string]int64, max map[string]int64) bool {
+	for name, bound := range min {
+		if value, ok := values[name]; !ok || value < bound {
+			return false
+		}
+	}
+	for name, bound := range max {
+		if value, ok := values[name]; !ok || value > bound {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LoadAchievements reads a JSON array of achievements and groups them by game mode
+func LoadAchievements(path string) (map[string][]*Achievement, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read achievements config: %w", err)
+	}
+
+	var achievements []*Achievement
+	if err := json.Unmarshal(bytes, &achievements); err != nil {
+		return nil, fmt.Errorf("failed to parse achievements config: %w", err)
+	}
+
+	byMode := make(map[string][]*Achievement)
+	ids := make(map[string]bool)
+	for _, a := range achievements {
+		if err := a.validate(); err != nil {
+			return nil, fmt.Errorf("invalid achievement %s: %w", a.Id, err)
+		}
+
+		// Unlocks are stored by id, so ids must be unique across game modes
+		if ids[a.Id] {
+			return nil, fmt.Errorf("duplicate achievement id: %s", a.Id)
+		}
+		ids[a.Id] = true
+
+		byMode[a.GameModeId] = append(byMode[a.GameModeId], a)
+	}
+
+	return byMode, nil
+}
+
+// CheckBadges returns an error naming the badges of the achievements that the badge service doesn't know,
+// as granting them would fail on every unlock
+func CheckBadges(ctx context.Context, badgeSvc badge.BadgeManagerClient, achievementsByMode map[string][]*Achievement) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	resp, err := badgeSvc.GetBadges(ctx, &badge.GetBadgesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get badges: %w", err)
+	}
+
+	known := make(map[string]bool, len(resp.Badges))
+	for _, b := range resp.Badges {
+		known[b.Id] = true
+	}
+
+	var missing []string
+	for _, achievements := range achievementsByMode {
+		for _, a := range achievements {
+			if a.BadgeId != "" && !known[a.BadgeId] {
+				missing = append(missing, fmt.Sprintf("%s (achievement %s)", a.BadgeId, a.Id))
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%w: %v", ErrUnknownBadge, missing)
+	}
+
+	return nil
+}
+
+var ErrUnknownBadge = errors.New("unknown badges")
+
+func (a *Achievement) validate() error {
+	if a.Id == "" {
+		return errors.New("id is required")
+	}
+	if a.GameModeId == "" {
+		return errors.New("gameModeId is required")
+	}
+	if a.Game == nil && a.Stats == nil {
+		return errors.New("at least one of game or stats is required")
+	}
+
+	return nil
+}
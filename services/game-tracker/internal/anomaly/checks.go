@@ -0,0 +1,60 @@
+package anomaly
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Check names stored on reviews
+const (
+	checkMaxCounterPrefix = "maxCounter:"
+	checkMinDuration      = "minDuration"
+	checkRecentWinRate    = "recentWinRate"
+	checkWinRateIncrease  = "winRateIncrease"
+)
+
+// Checks are the anomaly checks of one game mode. Unset checks are skipped.
+type Checks struct {
+	// MaxCounters flags players whose counters in a single game are above the maximum, e.g. {"kills": 40}
+	MaxCounters map[string]int64 `json:"maxCounters"`
+
+	// MinDurationSeconds flags finished games that ended sooner than this after starting
+	MinDurationSeconds int64 `json:"minDurationSeconds"`
+
+	WinRate *WinRateCheck `json:"winRate"`
+}
+
+// WinRateCheck compares a player's most recent games with the rest of their history of the game mode
+type WinRateCheck struct {
+	// RecentGames is how many of the player's latest games are checked. Players with fewer games aren't checked.
+	RecentGames int `json:"recentGames"`
+
+	// MaxRecentRate flags players who won more than this fraction of their recent games, 0 disables it
+	MaxRecentRate float64 `json:"maxRecentRate"`
+
+	// MaxIncrease flags players whose recent win rate is this much higher than their win rate before those games,
+	// 0 disables it. It is only checked once the player has at least RecentGames games before their recent games.
+	MaxIncrease float64 `json:"maxIncrease"`
+}
+
+// LoadChecks reads checks from a JSON object of game mode id to checks
+func LoadChecks(path string) (map[string]*Checks, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read anomaly config: %w", err)
+	}
+
+	var checks map[string]*Checks
+	if err := json.Unmarshal(bytes, &checks); err != nil {
+		return nil, fmt.Errorf("failed to parse anomaly config: %w", err)
+	}
+
+	for gameModeId, c := range checks {
+		if c.WinRate != nil && c.WinRate.RecentGames <= 0 {
+			return nil, fmt.Errorf("%s: winRate.recentGames must be positive", gameModeId)
+		}
+	}
+
+	return checks, nil
+}
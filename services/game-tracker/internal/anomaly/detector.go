@@ -0,0 +1,183 @@
+package anomaly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+// Detector flags suspicious finished games and players for review
+type Detector interface {
+	// Check runs the anomaly checks of the game's mode. Player stats must already include the game.
+	// Running it again for the same game doesn't create duplicate reviews.
+	// Every player is checked even if some fail, the returned error joins the failures.
+	Check(ctx context.Context, game *model.HistoricGame) error
+}
+
+// Publisher alerts other services, e.g. staff tools, of new reviews
+type Publisher interface {
+	ReviewCreated(ctx context.Context, review *model.Review) error
+}
+
+type detectorImpl struct {
+	logger    *zap.SugaredLogger
+	repo      repository.Repository
+	publisher Publisher
+
+	checksByMode map[string]*Checks
+}
+
+func NewDetector(logger *zap.SugaredLogger, repo repository.Repository, publisher Publisher,
+	checks map[string]*Checks) Detector {
+
+	return &detectorImpl{
+		logger:    logger,
+		repo:      repo,
+		publisher: publisher,
+
+		checksByMode: checks,
+	}
+}
+
+func (d *detectorImpl) Check(ctx context.Context, game *model.HistoricGame) error {
+	checks, ok := d.checksByMode[game.GameModeId]
+	if !ok || game.Outcome == model.GameOutcomeAbandoned {
+		return nil
+	}
+
+	var reviews []*model.Review
+	var errs []error
+	gameId := game.Id
+
+	if checks.MinDurationSeconds > 0 && game.StartTime != nil {
+		duration := game.EndTime.Sub(*game.StartTime).Seconds()
+		if duration < float64(checks.MinDurationSeconds) {
+			reviews = append(reviews, model.NewReview(game.GameModeId, &gameId, nil, checkMinDuration, duration,
+				float64(checks.MinDurationSeconds)))
+		}
+	}
+
+	for _, stats := range model.PlayerStatsFromGame(game) {
+		playerId := stats.PlayerId
+
+		for counter, max := range checks.MaxCounters {
+			if value := stats.Counters[counter]; value > max {
+				reviews = append(reviews, model.NewReview(game.GameModeId, &gameId, &playerId,
+					checkMaxCounterPrefix+counter, float64(value), float64(max)))
+			}
+		}
+
+		if checks.WinRate != nil {
+			winRateReviews, err := d.checkWinRate(ctx, checks.WinRate, game.GameModeId, playerId)
+			if err != nil {
+				// The other players are still checked, one player's failure shouldn't hide the rest
+				errs = append(errs, fmt.Errorf("failed to check win rate (playerId: %s): %w", playerId, err))
+				continue
+			}
+			reviews = append(reviews, winRateReviews...)
+		}
+	}
+
+	for _, r := range reviews {
+		if err := d.createReview(ctx, r); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *detectorImpl) checkWinRate(ctx context.Context, check *WinRateCheck, gameModeId string,
+	playerId uuid.UUID) ([]*model.Review, error) {
+
+	recent, err := d.repo.GetPlayerHistoricGames(ctx, repository.PlayerHistoryQuery{
+		PlayerId:   playerId,
+		GameModeId: &gameModeId,
+		Limit:      int64(check.RecentGames),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent games: %w", err)
+	}
+
+	// Abandoned games are in the history but not the stats, so only finished games are compared
+	var recentGames, recentWins int64
+	for _, g := range recent {
+		if g.Outcome == model.GameOutcomeAbandoned {
+			continue
+		}
+
+		recentGames++
+		if g.WinnerData != nil && containsPlayer(g.WinnerData.WinnerIds, playerId) {
+			recentWins++
+		}
+	}
+
+	if recentGames < int64(check.RecentGames) {
+		return nil, nil
+	}
+	recentRate := float64(recentWins) / float64(recentGames)
+
+	var reviews []*model.Review
+	if check.MaxRecentRate > 0 && recentRate > check.MaxRecentRate {
+		reviews = append(reviews, model.NewReview(gameModeId, nil, &playerId, checkRecentWinRate, recentRate,
+			check.MaxRecentRate))
+	}
+
+	if check.MaxIncrease > 0 {
+		stats, err := d.repo.GetPlayerStats(ctx, playerId, &gameModeId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get player stats: %w", err)
+		}
+
+		if len(stats) > 0 {
+			previousGames := stats[0].GamesPlayed - recentGames
+			previousWins := stats[0].Wins - recentWins
+
+			if previousGames >= int64(check.RecentGames) {
+				previousRate := float64(previousWins) / float64(previousGames)
+				if increase := recentRate - previousRate; increase > check.MaxIncrease {
+					reviews = append(reviews, model.NewReview(gameModeId, nil, &playerId, checkWinRateIncrease, increase,
+						check.MaxIncrease))
+				}
+			}
+		}
+	}
+
+	return reviews, nil
+}
+
+// createReview saves and publishes a review, unless the same review already exists. Player reviews of rolling stats
+// are only created if the player has no unresolved review for the same check.
+func (d *detectorImpl) createReview(ctx context.Context, r *model.Review) error {
+	if err := d.repo.CreateReview(ctx, r); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to create review: %w", err)
+	}
+
+	d.logger.Infow("flagged for review", "reviewId", r.Id.Hex(), "check", r.Check, "gameId", r.GameId,
+		"playerId", r.PlayerId, "value", r.Value, "threshold", r.Threshold)
+
+	if err := d.publisher.ReviewCreated(ctx, r); err != nil {
+		d.logger.Errorw("failed to publish review", "reviewId", r.Id.Hex(), "error", err)
+	}
+
+	return nil
+}
+
+func containsPlayer(ids []uuid.UUID, playerId uuid.UUID) bool {
+	for _, id := range ids {
+		if id == playerId {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,10 +2,28 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/achievements"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/anomaly"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/gameserver"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/kafka"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/leaderboard"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/livegames"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/parsers"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/reaper"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/retention"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/retry"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/rewards"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/service"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/badge"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/mcplayer"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"os/signal"
 	"sync"
 	"syscall"
@@ -16,6 +34,9 @@ func Run(cfg config.Config, logger *zap.SugaredLogger) {
 	defer cancel()
 	wg := &sync.WaitGroup{}
 
+	// Registers the game data types, which the repository needs to read games
+	parserRegistry := parsers.NewDefaultRegistry()
+
 	repoWg := &sync.WaitGroup{}
 	repoCtx, repoCancel := context.WithCancel(ctx)
 
@@ -24,9 +45,43 @@ func Run(cfg config.Config, logger *zap.SugaredLogger) {
 		logger.Fatalw("failed to create repository", err)
 	}
 
-	kafka.NewConsumer(ctx, wg, cfg.Kafka, logger, repo)
+	notifier := kafka.NewKafkaNotifier(ctx, wg, cfg.Kafka, logger)
+	handlerDeps := newMessageHandlerDeps(cfg, logger, repo, notifier)
+
+	watcher := livegames.NewWatcher(logger, repo, cfg.LiveGameRetryDelay)
+	watcher.Run(ctx, wg)
+
+	kafka.NewConsumer(ctx, wg, cfg.Kafka, cfg.Timeline, logger, repo, handlerDeps.rewardPayer,
+		handlerDeps.achievementEngine, handlerDeps.detector, parserRegistry)
+
+	leaderboard.RunRollover(ctx, wg, logger, repo)
+
+	var serverChecker gameserver.Checker
+	if cfg.Reaper.CheckServers {
+		serverChecker, err = gameserver.NewKubernetesChecker(cfg.Namespace)
+		if err != nil {
+			logger.Warnw("failed to create game server checker, abandoned games will only be reaped after timing out", "error", err)
+			serverChecker = nil
+		}
+	}
+
+	// instanceId identifies this replica for the leases of jobs that only run in one replica
+	instanceId := uuid.NewString()
+
+	reaper.New(logger, cfg.Reaper, repo, notifier, instanceId, serverChecker).Run(ctx, wg)
 
-	//service.RunServices(ctx, logger, wg, cfg, repo) todo: add services
+	retention.New(logger, cfg.Retention, repo, instanceId).Run(ctx, wg)
+
+	var retriers []retry.Retrier
+	if handlerDeps.rewardPayer != nil {
+		retriers = append(retriers, handlerDeps.rewardPayer)
+	}
+	if handlerDeps.achievementEngine != nil {
+		retriers = append(retriers, handlerDeps.achievementEngine)
+	}
+	retry.Run(ctx, wg, logger, cfg.Retry, repo, instanceId, retriers...)
+
+	service.RunServices(ctx, logger, wg, cfg, repo, watcher)
 
 	wg.Wait()
 	logger.Info("shutting down")
@@ -35,3 +90,61 @@ func Run(cfg config.Config, logger *zap.SugaredLogger) {
 	repoCancel()
 	repoWg.Wait()
 }
+
+// messageHandlerDeps are the optional dependencies of the game message handlers, nil if disabled in the config
+type messageHandlerDeps struct {
+	rewardPayer       rewards.Payer
+	achievementEngine achievements.Engine
+	detector          anomaly.Detector
+}
+
+func newMessageHandlerDeps(cfg config.Config, logger *zap.SugaredLogger, repo repository.Repository,
+	notifier kafka.Notifier) messageHandlerDeps {
+
+	var deps messageHandlerDeps
+
+	// The connection is only made once a client is used, so this is harmless if rewards and achievements are disabled
+	mcPlayerConn, err := grpc.NewClient(fmt.Sprintf("%s:%d", cfg.McPlayerService.Host, cfg.McPlayerService.Port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatalw("failed to connect to mc player service", err)
+	}
+
+	if cfg.RewardsConfigPath != "" {
+		rules, err := rewards.LoadRules(cfg.RewardsConfigPath)
+		if err != nil {
+			logger.Fatalw("failed to load rewards config", err)
+		}
+
+		deps.rewardPayer = rewards.NewPayer(logger, repo, mcplayer.NewMcPlayerClient(mcPlayerConn), rules)
+	}
+
+	if cfg.AchievementsConfigPath != "" {
+		achievementsByMode, err := achievements.LoadAchievements(cfg.AchievementsConfigPath)
+		if err != nil {
+			logger.Fatalw("failed to load achievements config", err)
+		}
+
+		badgeSvc := badge.NewBadgeManagerClient(mcPlayerConn)
+
+		// The badge service may not be up yet, in which case unknown badges show up as failed unlocks instead
+		err = achievements.CheckBadges(context.Background(), badgeSvc, achievementsByMode)
+		if errors.Is(err, achievements.ErrUnknownBadge) {
+			logger.Fatalw("achievements config has badges the badge service doesn't know", "error", err)
+		} else if err != nil {
+			logger.Warnw("failed to check achievement badges", "error", err)
+		}
+
+		deps.achievementEngine = achievements.NewEngine(logger, repo, badgeSvc, notifier, achievementsByMode)
+	}
+
+	if cfg.AnomalyConfigPath != "" {
+		checks, err := anomaly.LoadChecks(cfg.AnomalyConfigPath)
+		if err != nil {
+			logger.Fatalw("failed to load anomaly config", err)
+		}
+
+		deps.detector = anomaly.NewDetector(logger, repo, notifier, checks)
+	}
+
+	return deps
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/kafka"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/parsers"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"sync"
+)
+
+// ReplayQuarantined replays quarantined messages with the same rewards, achievements and anomaly checks as the
+// game tracker, see kafka.ReplayQuarantined.
+func ReplayQuarantined(ctx context.Context, cfg config.Config, logger *zap.SugaredLogger, repo repository.Repository,
+	registry *parsers.Registry, ids []primitive.ObjectID, dryRun bool) (int, error) {
+
+	// The notifier writes asynchronously, so it is closed before returning to flush what the handlers sent
+	notifierWg := &sync.WaitGroup{}
+	notifierCtx, notifierCancel := context.WithCancel(ctx)
+	defer func() {
+		notifierCancel()
+		notifierWg.Wait()
+	}()
+
+	notifier := kafka.NewKafkaNotifier(notifierCtx, notifierWg, cfg.Kafka, logger)
+	deps := newMessageHandlerDeps(cfg, logger, repo, notifier)
+
+	return kafka.ReplayQuarantined(ctx, logger, repo, cfg.Timeline, deps.rewardPayer, deps.achievementEngine,
+		deps.detector, registry, ids, dryRun)
+}
@@ -1,10 +1,12 @@
 package config
 
 import (
+	"fmt"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/utils/runtime"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"strings"
+	"time"
 )
 
 const (
@@ -13,6 +15,35 @@ const (
 	mongoDBURIFlag  = "mongodb-uri"
 	developmentFlag = "development"
 	grpcPortFlag    = "port"
+
+	namespaceFlag = "namespace"
+
+	reaperIntervalFlag     = "reaper-interval"
+	reaperTimeoutFlag      = "reaper-timeout"
+	reaperModeTimeoutsFlag = "reaper-mode-timeouts"
+	reaperCheckServersFlag = "reaper-check-servers"
+	reaperServerGraceFlag  = "reaper-server-grace-period"
+
+	timelineEnabledFlag    = "timeline-enabled"
+	timelineMaxEntriesFlag = "timeline-max-entries"
+
+	liveGameRetryDelayFlag = "live-game-watch-retry-delay"
+
+	mcPlayerServiceHostFlag = "mc-player-service-host"
+	mcPlayerServicePortFlag = "mc-player-service-port"
+
+	rewardsConfigPathFlag      = "rewards-config-path"
+	achievementsConfigPathFlag = "achievements-config-path"
+	anomalyConfigPathFlag      = "anomaly-config-path"
+
+	retentionIntervalFlag           = "retention-interval"
+	retentionSummarizeAfterFlag     = "retention-summarize-after"
+	retentionDeleteAfterFlag        = "retention-delete-after"
+	retentionModeSummarizeAfterFlag = "retention-mode-summarize-after"
+	retentionModeDeleteAfterFlag    = "retention-mode-delete-after"
+
+	retryIntervalFlag    = "retry-interval"
+	retryMaxAttemptsFlag = "retry-max-attempts"
 )
 
 func LoadGlobalConfig() Config {
@@ -21,12 +52,54 @@ func LoadGlobalConfig() Config {
 	viper.SetDefault(mongoDBURIFlag, "mongodb://localhost:27017")
 	viper.SetDefault(developmentFlag, true)
 	viper.SetDefault(grpcPortFlag, 10010)
+	viper.SetDefault(namespaceFlag, "emortalmc")
+	viper.SetDefault(reaperIntervalFlag, time.Minute)
+	viper.SetDefault(reaperTimeoutFlag, 10*time.Minute)
+	viper.SetDefault(reaperModeTimeoutsFlag, "")
+	viper.SetDefault(reaperCheckServersFlag, true)
+	viper.SetDefault(reaperServerGraceFlag, time.Minute)
+	viper.SetDefault(timelineEnabledFlag, true)
+	viper.SetDefault(timelineMaxEntriesFlag, 1000)
+	viper.SetDefault(liveGameRetryDelayFlag, 5*time.Second)
+	viper.SetDefault(mcPlayerServiceHostFlag, "localhost")
+	viper.SetDefault(mcPlayerServicePortFlag, 10004)
+	viper.SetDefault(rewardsConfigPathFlag, "")
+	viper.SetDefault(achievementsConfigPathFlag, "")
+	viper.SetDefault(anomalyConfigPathFlag, "")
+	viper.SetDefault(retentionIntervalFlag, time.Hour)
+	viper.SetDefault(retentionSummarizeAfterFlag, time.Duration(0))
+	viper.SetDefault(retentionDeleteAfterFlag, time.Duration(0))
+	viper.SetDefault(retentionModeSummarizeAfterFlag, "")
+	viper.SetDefault(retentionModeDeleteAfterFlag, "")
+	viper.SetDefault(retryIntervalFlag, 5*time.Minute)
+	viper.SetDefault(retryMaxAttemptsFlag, 5)
 
 	pflag.String(kafkaHostFlag, viper.GetString(kafkaHostFlag), "Kafka host")
 	pflag.Int32(kafkaPortFlag, viper.GetInt32(kafkaPortFlag), "Kafka port")
 	pflag.String(mongoDBURIFlag, viper.GetString(mongoDBURIFlag), "MongoDB URI")
 	pflag.Bool(developmentFlag, viper.GetBool(developmentFlag), "Development mode")
 	pflag.Int32(grpcPortFlag, viper.GetInt32(grpcPortFlag), "gRPC port")
+	pflag.String(namespaceFlag, viper.GetString(namespaceFlag), "Namespace that game servers are in")
+	pflag.Duration(reaperIntervalFlag, viper.GetDuration(reaperIntervalFlag), "Delay between checks for abandoned live games")
+	pflag.Duration(reaperTimeoutFlag, viper.GetDuration(reaperTimeoutFlag), "Time without updates after which a live game is abandoned")
+	pflag.String(reaperModeTimeoutsFlag, viper.GetString(reaperModeTimeoutsFlag), "Per game mode abandon timeouts, e.g. block_sumo=5m,tower_defence=20m")
+	pflag.Bool(reaperCheckServersFlag, viper.GetBool(reaperCheckServersFlag), "Abandon live games whose game server no longer exists")
+	pflag.Duration(reaperServerGraceFlag, viper.GetDuration(reaperServerGraceFlag), "Minimum game age before a missing game server abandons it")
+	pflag.Bool(timelineEnabledFlag, viper.GetBool(timelineEnabledFlag), "Record a timeline of every game's updates")
+	pflag.Duration(liveGameRetryDelayFlag, viper.GetDuration(liveGameRetryDelayFlag), "Delay before reopening the live game change stream after it fails")
+	pflag.String(mcPlayerServiceHostFlag, viper.GetString(mcPlayerServiceHostFlag), "McPlayerService host")
+	pflag.Int32(mcPlayerServicePortFlag, viper.GetInt32(mcPlayerServicePortFlag), "McPlayerService port")
+	pflag.String(rewardsConfigPathFlag, viper.GetString(rewardsConfigPathFlag), "Path to the JSON file of per game mode XP rewards. Rewards are disabled if empty")
+	pflag.String(achievementsConfigPathFlag, viper.GetString(achievementsConfigPathFlag), "Path to the JSON file of achievements. Achievements are disabled if empty")
+	pflag.String(anomalyConfigPathFlag, viper.GetString(anomalyConfigPathFlag), "Path to the JSON file of per game mode anomaly checks. Checks are disabled if empty")
+	pflag.Int32(timelineMaxEntriesFlag, viper.GetInt32(timelineMaxEntriesFlag), "Maximum timeline entries kept per game, older entries are dropped")
+	pflag.Duration(retentionIntervalFlag, viper.GetDuration(retentionIntervalFlag), "Delay between historic game retention runs")
+	pflag.Duration(retentionSummarizeAfterFlag, viper.GetDuration(retentionSummarizeAfterFlag), "Age after which historic games are reduced to a summary, 0 keeps them in full")
+	pflag.Duration(retentionDeleteAfterFlag, viper.GetDuration(retentionDeleteAfterFlag), "Age after which historic games are deleted, 0 keeps them forever")
+	pflag.String(retentionModeSummarizeAfterFlag, viper.GetString(retentionModeSummarizeAfterFlag), "Per game mode summarize ages, e.g. block_sumo=720h,tower_defence=2160h")
+	pflag.String(retentionModeDeleteAfterFlag, viper.GetString(retentionModeDeleteAfterFlag), "Per game mode delete ages, e.g. block_sumo=8760h,tower_defence=0s")
+	pflag.Duration(retryIntervalFlag, viper.GetDuration(retryIntervalFlag), "Delay between retries of failed reward payouts and achievement badges")
+	pflag.Int32(retryMaxAttemptsFlag, viper.GetInt32(retryMaxAttemptsFlag), "Failed attempts after which reward payouts and achievement badges are no longer retried")
 	pflag.Parse()
 
 	// Bind the viper flags to environment variables
@@ -36,6 +109,55 @@ func LoadGlobalConfig() Config {
 	runtime.Must(viper.BindEnv(mongoDBURIFlag))
 	runtime.Must(viper.BindEnv(developmentFlag))
 	runtime.Must(viper.BindEnv(grpcPortFlag))
+	runtime.Must(viper.BindEnv(namespaceFlag))
+	runtime.Must(viper.BindEnv(reaperIntervalFlag))
+	runtime.Must(viper.BindEnv(reaperTimeoutFlag))
+	runtime.Must(viper.BindEnv(reaperModeTimeoutsFlag))
+	runtime.Must(viper.BindEnv(reaperCheckServersFlag))
+	runtime.Must(viper.BindEnv(reaperServerGraceFlag))
+	runtime.Must(viper.BindEnv(timelineEnabledFlag))
+	runtime.Must(viper.BindEnv(timelineMaxEntriesFlag))
+	runtime.Must(viper.BindEnv(liveGameRetryDelayFlag))
+	runtime.Must(viper.BindEnv(mcPlayerServiceHostFlag))
+	runtime.Must(viper.BindEnv(mcPlayerServicePortFlag))
+	runtime.Must(viper.BindEnv(rewardsConfigPathFlag))
+	runtime.Must(viper.BindEnv(achievementsConfigPathFlag))
+	runtime.Must(viper.BindEnv(anomalyConfigPathFlag))
+	runtime.Must(viper.BindEnv(retentionIntervalFlag))
+	runtime.Must(viper.BindEnv(retentionSummarizeAfterFlag))
+	runtime.Must(viper.BindEnv(retentionDeleteAfterFlag))
+	runtime.Must(viper.BindEnv(retentionModeSummarizeAfterFlag))
+	runtime.Must(viper.BindEnv(retentionModeDeleteAfterFlag))
+	runtime.Must(viper.BindEnv(retryIntervalFlag))
+	runtime.Must(viper.BindEnv(retryMaxAttemptsFlag))
+
+	modeTimeouts, err := parseModeDurations(viper.GetString(reaperModeTimeoutsFlag))
+	if err != nil {
+		panic(fmt.Sprintf("invalid %s: %s", reaperModeTimeoutsFlag, err))
+	}
+
+	modeSummarizeAfter, err := parseModeDurations(viper.GetString(retentionModeSummarizeAfterFlag))
+	if err != nil {
+		panic(fmt.Sprintf("invalid %s: %s", retentionModeSummarizeAfterFlag, err))
+	}
+
+	modeDeleteAfter, err := parseModeDurations(viper.GetString(retentionModeDeleteAfterFlag))
+	if err != nil {
+		panic(fmt.Sprintf("invalid %s: %s", retentionModeDeleteAfterFlag, err))
+	}
+
+	if viper.GetDuration(retentionIntervalFlag) <= 0 {
+		panic(fmt.Sprintf("invalid %s: must be greater than 0", retentionIntervalFlag))
+	}
+
+	if viper.GetDuration(retryIntervalFlag) <= 0 {
+		panic(fmt.Sprintf("invalid %s: must be greater than 0", retryIntervalFlag))
+	}
+
+	// A limit of 0 would empty every timeline
+	if viper.GetBool(timelineEnabledFlag) && viper.GetInt32(timelineMaxEntriesFlag) <= 0 {
+		panic(fmt.Sprintf("invalid %s: must be greater than 0", timelineMaxEntriesFlag))
+	}
 
 	return Config{
 		Kafka: KafkaConfig{
@@ -47,9 +169,64 @@ func LoadGlobalConfig() Config {
 		},
 		Development: viper.GetBool(developmentFlag),
 		GRPCPort:    int(viper.GetInt32(grpcPortFlag)),
+		Namespace:   viper.GetString(namespaceFlag),
+		Reaper: ReaperConfig{
+			Interval:          viper.GetDuration(reaperIntervalFlag),
+			DefaultTimeout:    viper.GetDuration(reaperTimeoutFlag),
+			ModeTimeouts:      modeTimeouts,
+			CheckServers:      viper.GetBool(reaperCheckServersFlag),
+			ServerGracePeriod: viper.GetDuration(reaperServerGraceFlag),
+		},
+		Timeline: TimelineConfig{
+			Enabled:    viper.GetBool(timelineEnabledFlag),
+			MaxEntries: int(viper.GetInt32(timelineMaxEntriesFlag)),
+		},
+		LiveGameRetryDelay: viper.GetDuration(liveGameRetryDelayFlag),
+		McPlayerService: McPlayerServiceConfig{
+			Host: viper.GetString(mcPlayerServiceHostFlag),
+			Port: uint16(viper.GetInt32(mcPlayerServicePortFlag)),
+		},
+		RewardsConfigPath:      viper.GetString(rewardsConfigPathFlag),
+		AchievementsConfigPath: viper.GetString(achievementsConfigPathFlag),
+		AnomalyConfigPath:      viper.GetString(anomalyConfigPathFlag),
+		Retention: RetentionConfig{
+			Interval:              viper.GetDuration(retentionIntervalFlag),
+			DefaultSummarizeAfter: viper.GetDuration(retentionSummarizeAfterFlag),
+			DefaultDeleteAfter:    viper.GetDuration(retentionDeleteAfterFlag),
+			ModeSummarizeAfter:    modeSummarizeAfter,
+			ModeDeleteAfter:       modeDeleteAfter,
+		},
+		Retry: RetryConfig{
+			Interval:    viper.GetDuration(retryIntervalFlag),
+			MaxAttempts: int(viper.GetInt32(retryMaxAttemptsFlag)),
+		},
 	}
 }
 
+// parseModeDurations parses a list in the format "modeA=5m,modeB=1h"
+func parseModeDurations(str string) (map[string]time.Duration, error) {
+	durations := make(map[string]time.Duration)
+	if str == "" {
+		return durations, nil
+	}
+
+	for _, pair := range strings.Split(str, ",") {
+		mode, durationStr, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("expected mode=duration, got %s", pair)
+		}
+
+		duration, err := time.ParseDuration(durationStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration for %s: %w", mode, err)
+		}
+
+		durations[mode] = duration
+	}
+
+	return durations, nil
+}
+
 type Config struct {
 	Kafka   KafkaConfig
 	MongoDB MongoDBConfig
@@ -57,6 +234,94 @@ type Config struct {
 	Development bool
 
 	GRPCPort int
+
+	Namespace string
+
+	Reaper   ReaperConfig
+	Timeline TimelineConfig
+
+	// LiveGameRetryDelay is how long to wait before reopening the live game change stream after it fails
+	LiveGameRetryDelay time.Duration
+
+	McPlayerService McPlayerServiceConfig
+
+	// RewardsConfigPath is the JSON file of XP rewards per game mode. Rewards are disabled if it is empty.
+	RewardsConfigPath string
+	// AchievementsConfigPath is the JSON file of achievements. Achievements are disabled if it is empty.
+	AchievementsConfigPath string
+	// AnomalyConfigPath is the JSON file of anomaly checks per game mode. Checks are disabled if it is empty.
+	AnomalyConfigPath string
+
+	Retention RetentionConfig
+	Retry     RetryConfig
+}
+
+// RetryConfig controls how failed reward payouts and achievement badges are retried
+type RetryConfig struct {
+	Interval time.Duration
+	// MaxAttempts is how many failed attempts a record can have and still be retried, including the first attempt
+	MaxAttempts int
+}
+
+// RetentionConfig controls how long historic games are kept. An age of 0 keeps games forever.
+type RetentionConfig struct {
+	Interval time.Duration
+
+	// DefaultSummarizeAfter and DefaultDeleteAfter are used for game modes without an entry in the mode maps
+	DefaultSummarizeAfter time.Duration
+	DefaultDeleteAfter    time.Duration
+	ModeSummarizeAfter    map[string]time.Duration
+	ModeDeleteAfter       map[string]time.Duration
+}
+
+// SummarizeAfter returns the age after which games of the mode are summarized, or 0 if they never are
+func (c RetentionConfig) SummarizeAfter(gameModeId string) time.Duration {
+	if age, ok := c.ModeSummarizeAfter[gameModeId]; ok {
+		return age
+	}
+
+	return c.DefaultSummarizeAfter
+}
+
+// DeleteAfter returns the age after which games of the mode are deleted, or 0 if they never are
+func (c RetentionConfig) DeleteAfter(gameModeId string) time.Duration {
+	if age, ok := c.ModeDeleteAfter[gameModeId]; ok {
+		return age
+	}
+
+	return c.DefaultDeleteAfter
+}
+
+type McPlayerServiceConfig struct {
+	Host string
+	Port uint16
+}
+
+type TimelineConfig struct {
+	Enabled bool
+	// MaxEntries is how many of the newest entries are kept per game
+	MaxEntries int
+}
+
+type ReaperConfig struct {
+	Interval time.Duration
+
+	// DefaultTimeout is used for game modes without an entry in ModeTimeouts
+	DefaultTimeout time.Duration
+	ModeTimeouts   map[string]time.Duration
+
+	// CheckServers abandons games whose game server no longer exists without waiting for the timeout
+	CheckServers bool
+	// ServerGracePeriod is how old a game must be before its server is checked, as the server may not be visible yet
+	ServerGracePeriod time.Duration
+}
+
+func (c ReaperConfig) Timeout(gameModeId string) time.Duration {
+	if timeout, ok := c.ModeTimeouts[gameModeId]; ok {
+		return timeout
+	}
+
+	return c.DefaultTimeout
 }
 
 type KafkaConfig struct {
@@ -0,0 +1,149 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io"
+	"os"
+	"time"
+)
+
+type Options struct {
+	Format     Format
+	GameModeId *string
+
+	// From is inclusive, To is exclusive. Both are compared against the end time of the game.
+	From *time.Time
+	To   *time.Time
+
+	// CursorPath is a file storing the last exported game. If set, only games after the cursor are exported
+	// and the cursor is updated once the export succeeds.
+	CursorPath string
+}
+
+type Result struct {
+	Games int
+	// Cursor is the last exported game, or the previous cursor if no games were exported
+	Cursor *repository.HistoryCursor
+}
+
+type savedCursor struct {
+	EndTime time.Time `json:"endTime"`
+	GameId  string    `json:"gameId"`
+}
+
+// Run writes historic games to out, ordered by end time, and closes out. The cursor is only updated once out was
+// closed successfully, so games are never skipped because they weren't fully written.
+// Incremental exports should set To to a time in the past, as games finishing out of order can be stored
+// with an end time before the cursor and would be skipped.
+func Run(ctx context.Context, repo repository.Repository, opts Options, out io.WriteCloser) (Result, error) {
+	result, err := write(ctx, repo, opts, out)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	if opts.CursorPath != "" && result.Cursor != nil {
+		if err := writeCursor(opts.CursorPath, result.Cursor); err != nil {
+			return Result{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func write(ctx context.Context, repo repository.Repository, opts Options, out io.Writer) (Result, error) {
+	w, err := newRecordWriter(opts.Format, out)
+	if err != nil {
+		return Result{}, err
+	}
+
+	cursor, err := readCursor(opts.CursorPath)
+	if err != nil {
+		return Result{}, err
+	}
+
+	query := repository.HistoricGameQuery{
+		GameModeId: opts.GameModeId,
+		From:       opts.From,
+		To:         opts.To,
+		After:      cursor,
+	}
+
+	result := Result{Cursor: cursor}
+	err = repo.ForEachHistoricGame(ctx, query, func(game *model.HistoricGame) error {
+		r, err := newRecord(game)
+		if err != nil {
+			return fmt.Errorf("failed to create record for game %s: %w", game.Id.Hex(), err)
+		}
+
+		if err := w.Write(r); err != nil {
+			return fmt.Errorf("failed to write game %s: %w", game.Id.Hex(), err)
+		}
+
+		result.Games++
+		result.Cursor = &repository.HistoryCursor{EndTime: game.EndTime, Id: game.Id}
+		return nil
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	if err := w.Flush(); err != nil {
+		return Result{}, fmt.Errorf("failed to flush output: %w", err)
+	}
+
+	return result, nil
+}
+
+func readCursor(path string) (*repository.HistoryCursor, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil // First export
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cursor: %w", err)
+	}
+
+	var saved savedCursor
+	if err := json.Unmarshal(bytes, &saved); err != nil {
+		return nil, fmt.Errorf("failed to parse cursor: %w", err)
+	}
+
+	id, err := primitive.ObjectIDFromHex(saved.GameId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cursor game id: %w", err)
+	}
+
+	return &repository.HistoryCursor{EndTime: saved.EndTime, Id: id}, nil
+}
+
+// writeCursor replaces the cursor file atomically so a failed write never loses the previous cursor
+func writeCursor(path string, cursor *repository.HistoryCursor) error {
+	bytes, err := json.Marshal(savedCursor{EndTime: cursor.EndTime, GameId: cursor.Id.Hex()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cursor: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bytes, 0o644); err != nil {
+		return fmt.Errorf("failed to write cursor: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace cursor: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+)
+
+// output buffers writes to a file or stdout. Closing it only succeeds once everything was written to disk.
+type output struct {
+	*bufio.Writer
+	file *os.File
+}
+
+// OpenOutput creates the file at path to export to, or writes to stdout if path is "-"
+func OpenOutput(path string) (io.WriteCloser, error) {
+	if path == "-" {
+		return &output{Writer: bufio.NewWriter(os.Stdout)}, nil
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	return &output{Writer: bufio.NewWriter(file), file: file}, nil
+}
+
+func (o *output) Close() error {
+	if err := o.Flush(); err != nil {
+		if o.file != nil {
+			_ = o.file.Close()
+		}
+		return fmt.Errorf("failed to flush output: %w", err)
+	}
+
+	if o.file == nil {
+		return nil
+	}
+
+	if err := o.file.Sync(); err != nil {
+		_ = o.file.Close()
+		return fmt.Errorf("failed to sync output file: %w", err)
+	}
+
+	if err := o.file.Close(); err != nil {
+		return fmt.Errorf("failed to close output file: %w", err)
+	}
+
+	return nil
+}
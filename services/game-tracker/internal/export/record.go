@@ -0,0 +1,119 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"google.golang.org/protobuf/encoding/protojson"
+	"time"
+)
+
+// record is a historic game flattened for analytics. Fields must only be added, never renamed or removed.
+// CSV columns are positional, so a new field's column must be added to the end of both csvHeader and csvWriter.Write.
+type record struct {
+	GameId          string       `json:"gameId"`
+	GameModeId      string       `json:"gameModeId"`
+	ServerId        string       `json:"serverId"`
+	Outcome         string       `json:"outcome"`
+	StartTime       *time.Time   `json:"startTime"`
+	EndTime         time.Time    `json:"endTime"`
+	DurationSeconds *int64       `json:"durationSeconds"`
+	PlayerIds       []string     `json:"playerIds"`
+	PlayerUsernames []string     `json:"playerUsernames"`
+	Teams           []recordTeam `json:"teams"`
+	WinnerIds       []string     `json:"winnerIds"`
+	LoserIds        []string     `json:"loserIds"`
+	// GameDataType is the full proto name of GameData, e.g. emortal.model.game_tracker.BlockSumoFinishData
+	GameDataType    string          `json:"gameDataType"`
+	GameData        json.RawMessage `json:"gameData"`
+	RawContentTypes []string        `json:"rawContentTypes"`
+	// SummarizedAt is set once retention removed the game data and raw content of the game
+	SummarizedAt *time.Time `json:"summarizedAt"`
+	MapId        *string    `json:"mapId"`
+	MatchId      *string    `json:"matchId"`
+	Private      bool       `json:"private"`
+}
+
+type recordTeam struct {
+	Id           string   `json:"id"`
+	FriendlyName string   `json:"friendlyName"`
+	Color        int32    `json:"color"`
+	PlayerIds    []string `json:"playerIds"`
+}
+
+// csvHeader must be in the same order as the columns of csvWriter.Write
+var csvHeader = []string{
+	"game_id", "game_mode_id", "server_id", "outcome", "start_time", "end_time", "duration_seconds",
+	"player_ids", "player_usernames", "teams", "winner_ids", "loser_ids", "game_data_type", "game_data",
+	"raw_content_types", "summarized_at", "map_id", "match_id", "private",
+}
+
+func newRecord(g *model.HistoricGame) (*record, error) {
+	outcome := g.Outcome
+	if outcome == "" {
+		outcome = model.GameOutcomeFinished
+	}
+
+	r := &record{
+		GameId:          g.Id.Hex(),
+		GameModeId:      g.GameModeId,
+		ServerId:        g.ServerId,
+		Outcome:         string(outcome),
+		StartTime:       g.StartTime,
+		EndTime:         g.EndTime,
+		PlayerIds:       make([]string, len(g.Players)),
+		PlayerUsernames: make([]string, len(g.Players)),
+		Teams:           []recordTeam{},
+		WinnerIds:       []string{},
+		LoserIds:        []string{},
+		RawContentTypes: make([]string, len(g.RawContent)),
+		SummarizedAt:    g.SummarizedAt,
+		MapId:           g.MapId,
+		MatchId:         g.MatchId,
+		Private:         g.Private,
+	}
+
+	if g.StartTime != nil {
+		duration := int64(g.EndTime.Sub(*g.StartTime).Seconds())
+		r.DurationSeconds = &duration
+	}
+
+	for i, p := range g.Players {
+		r.PlayerIds[i] = p.Id.String()
+		r.PlayerUsernames[i] = p.Username
+	}
+
+	if g.TeamData != nil {
+		for _, t := range *g.TeamData {
+			r.Teams = append(r.Teams, recordTeam{
+				Id:           t.Id,
+				FriendlyName: t.FriendlyName,
+				Color:        t.Color,
+				PlayerIds:    model.UuidsToStrings(t.PlayerIds),
+			})
+		}
+	}
+
+	if g.WinnerData != nil {
+		r.WinnerIds = model.UuidsToStrings(g.WinnerData.WinnerIds)
+		r.LoserIds = model.UuidsToStrings(g.WinnerData.LoserIds)
+	}
+
+	if data, ok := g.GameData.(model.ProtoGameData); ok {
+		msg := data.ToProto()
+
+		bytes, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal game data: %w", err)
+		}
+
+		r.GameDataType = string(msg.ProtoReflect().Descriptor().FullName())
+		r.GameData = bytes
+	}
+
+	for i, c := range g.RawContent {
+		r.RawContentTypes[i] = c.Type
+	}
+
+	return r, nil
+}
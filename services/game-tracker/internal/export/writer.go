@@ -0,0 +1,113 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format string
+
+const (
+	FormatNDJSON Format = "ndjson"
+	FormatCSV    Format = "csv"
+)
+
+type recordWriter interface {
+	Write(r *record) error
+	Flush() error
+}
+
+func newRecordWriter(format Format, w io.Writer) (recordWriter, error) {
+	switch format {
+	case FormatNDJSON:
+		return &ndjsonWriter{enc: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return newCSVWriter(w)
+	default:
+		return nil, fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+}
+
+func (w *ndjsonWriter) Write(r *record) error {
+	return w.enc.Encode(r) // Encode writes a newline after every value
+}
+
+func (w *ndjsonWriter) Flush() error {
+	return nil
+}
+
+// listSeparator joins list columns in CSV, ids and usernames can't contain it
+const listSeparator = ";"
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return nil, fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	return &csvWriter{w: cw}, nil
+}
+
+func (w *csvWriter) Write(r *record) error {
+	teams, err := json.Marshal(r.Teams)
+	if err != nil {
+		return fmt.Errorf("failed to marshal teams: %w", err)
+	}
+
+	var startTime, duration, summarizedAt, mapId, matchId string
+	if r.StartTime != nil {
+		startTime = r.StartTime.UTC().Format(time.RFC3339)
+	}
+	if r.MapId != nil {
+		mapId = *r.MapId
+	}
+	if r.MatchId != nil {
+		matchId = *r.MatchId
+	}
+	if r.SummarizedAt != nil {
+		summarizedAt = r.SummarizedAt.UTC().Format(time.RFC3339)
+	}
+	if r.DurationSeconds != nil {
+		duration = strconv.FormatInt(*r.DurationSeconds, 10)
+	}
+
+	return w.w.Write([]string{
+		r.GameId,
+		r.GameModeId,
+		r.ServerId,
+		r.Outcome,
+		startTime,
+		r.EndTime.UTC().Format(time.RFC3339),
+		duration,
+		strings.Join(r.PlayerIds, listSeparator),
+		strings.Join(r.PlayerUsernames, listSeparator),
+		string(teams),
+		strings.Join(r.WinnerIds, listSeparator),
+		strings.Join(r.LoserIds, listSeparator),
+		r.GameDataType,
+		string(r.GameData),
+		strings.Join(r.RawContentTypes, listSeparator),
+		summarizedAt,
+		mapId,
+		matchId,
+		strconv.FormatBool(r.Private),
+	})
+}
+
+func (w *csvWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
@@ -0,0 +1,51 @@
+package gameserver
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/utils"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"time"
+)
+
+// Checker checks whether a game server still exists
+type Checker interface {
+	Exists(ctx context.Context, serverId string) (bool, error)
+}
+
+// kubernetesChecker looks up the pod of a game server. Agones names the pod after the GameServer,
+// which is what games send as their server id.
+type kubernetesChecker struct {
+	client    *kubernetes.Clientset
+	namespace string
+}
+
+func NewKubernetesChecker(namespace string) (Checker, error) {
+	kubeConfig, err := utils.CreateKubernetesConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes config: %w", err)
+	}
+	kubeConfig.Timeout = 5 * time.Second
+
+	client, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	return &kubernetesChecker{client: client, namespace: namespace}, nil
+}
+
+func (c *kubernetesChecker) Exists(ctx context.Context, serverId string) (bool, error) {
+	_, err := c.client.CoreV1().Pods(c.namespace).Get(ctx, serverId, metav1.GetOptions{})
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	return false, err
+}
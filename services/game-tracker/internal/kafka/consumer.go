@@ -2,20 +2,26 @@ package kafka
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/achievements"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/anomaly"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/parsers"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/rewards"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/utils"
 	"github.com/emortalmc/proto-specs/gen/go/message/gametracker"
 	"github.com/emortalmc/proto-specs/gen/go/nongenerated/kafkautils"
 	"github.com/segmentio/kafka-go"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/known/anypb"
-	"strings"
 	"sync"
 	"time"
 )
@@ -26,14 +32,18 @@ type consumer struct {
 	logger *zap.SugaredLogger
 	repo   repository.Repository
 
-	reader *kafka.Reader
+	parsers *parsers.Registry
 
-	liveHandler     *parserHandler[model.LiveGame]
-	historicHandler *parserHandler[model.HistoricGame]
+	timelineCfg  config.TimelineConfig
+	rewardPayer  rewards.Payer
+	achievements achievements.Engine
+	detector     anomaly.Detector
 }
 
-func NewConsumer(ctx context.Context, wg *sync.WaitGroup, cfg config.KafkaConfig, logger *zap.SugaredLogger,
-	repo repository.Repository) {
+// NewConsumer rewardPayer, achievementEngine and detector may be nil if rewards, achievements or anomaly checks are disabled
+func NewConsumer(ctx context.Context, wg *sync.WaitGroup, cfg config.KafkaConfig, timelineCfg config.TimelineConfig,
+	logger *zap.SugaredLogger, repo repository.Repository, rewardPayer rewards.Payer,
+	achievementEngine achievements.Engine, detector anomaly.Detector, registry *parsers.Registry) {
 
 	reader := kafka.NewReader(kafka.ReaderConfig{
 		Brokers:     []string{cfg.Host},
@@ -44,135 +54,315 @@ func NewConsumer(ctx context.Context, wg *sync.WaitGroup, cfg config.KafkaConfig
 		ErrorLogger: kafkautils.CreateErrorLogger(logger),
 	})
 
-	c := &consumer{
-		logger: logger,
-		repo:   repo,
-
-		reader: reader,
-
-		liveHandler:     &parserHandler[model.LiveGame]{logger: logger, parsers: parsers.LiveParsers},
-		historicHandler: &parserHandler[model.HistoricGame]{logger: logger, parsers: parsers.HistoricParsers},
-	}
-
-	handler := kafkautils.NewConsumerHandler(logger, reader)
-	handler.RegisterHandler(&gametracker.GameStartMessage{}, c.handleGameStartMessage)
-	handler.RegisterHandler(&gametracker.GameUpdateMessage{}, c.handleGameUpdateMessage)
-	handler.RegisterHandler(&gametracker.GameFinishMessage{}, c.handleGameFinishMessage)
+	c := newConsumer(logger, repo, timelineCfg, rewardPayer, achievementEngine, detector, registry)
 
 	logger.Infow("started listening for kafka messages", "topics", reader.Config().GroupTopics)
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		handler.Run(ctx) // Run is blocking until the context is cancelled
+		c.run(ctx, reader) // run is blocking until the context is cancelled
 		if err := reader.Close(); err != nil {
 			logger.Errorw("failed to close kafka reader", err)
 		}
 	}()
 }
 
-func (c *consumer) handleGameStartMessage(ctx context.Context, _ *kafka.Message, uncastMsg proto.Message) {
+func newConsumer(logger *zap.SugaredLogger, repo repository.Repository, timelineCfg config.TimelineConfig,
+	rewardPayer rewards.Payer, achievementEngine achievements.Engine, detector anomaly.Detector,
+	registry *parsers.Registry) *consumer {
+
+	return &consumer{
+		logger: logger,
+		repo:   repo,
+
+		parsers: registry,
+
+		timelineCfg:  timelineCfg,
+		rewardPayer:  rewardPayer,
+		achievements: achievementEngine,
+		detector:     detector,
+	}
+}
+
+type messageHandler func(ctx context.Context, kafkaMsg *kafka.Message, uncastMsg proto.Message) error
+
+// handlers are the handlers of each game message type, used by both the Kafka consumer and quarantine replays
+func (c *consumer) handlers() map[protoreflect.MessageType]messageHandler {
+	return map[protoreflect.MessageType]messageHandler{
+		(&gametracker.GameStartMessage{}).ProtoReflect().Type():  c.handleGameStartMessage,
+		(&gametracker.GameUpdateMessage{}).ProtoReflect().Type(): c.handleGameUpdateMessage,
+		(&gametracker.GameFinishMessage{}).ProtoReflect().Type(): c.handleGameFinishMessage,
+	}
+}
+
+// run reads messages the same way as kafkautils.ConsumerHandler, except that messages of a handled type that can't be
+// unmarshalled are quarantined too, rather than only logged.
+func (c *consumer) run(ctx context.Context, reader *kafka.Reader) {
+	handlers := c.handlers()
+
+	for {
+		m, err := reader.ReadMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Errorw("failed to read kafka message", "error", err)
+			continue
+		}
+
+		c.handleMessage(ctx, handlers, &m)
+	}
+}
+
+func (c *consumer) handleMessage(ctx context.Context, handlers map[protoreflect.MessageType]messageHandler,
+	kafkaMsg *kafka.Message) {
+
+	protoName, err := kafkautils.ProtoTypeFromHeaders(kafkaMsg.Headers)
+	if err != nil {
+		c.logger.Errorw("failed to get proto type from message headers", "partition", kafkaMsg.Partition,
+			"offset", kafkaMsg.Offset, "error", err)
+		return
+	}
+
+	protoType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(protoName))
+	if err != nil {
+		c.logger.Errorw("failed to find proto type", "protoType", protoName, "error", err)
+		return
+	}
+
+	handler, ok := handlers[protoType]
+	if !ok {
+		return
+	}
+
+	msg := protoType.New().Interface()
+	if err := proto.Unmarshal(kafkaMsg.Value, msg); err != nil {
+		c.quarantine(ctx, kafkaMsg, fmt.Errorf("failed to unmarshal message: %w", err))
+		return
+	}
+
+	if err := handler(ctx, kafkaMsg, msg); err != nil {
+		c.quarantine(ctx, kafkaMsg, err)
+	}
+}
+
+// quarantine stores messages that couldn't be applied, so they can be replayed with the replay-quarantine command once
+// the cause is fixed. The message offset is already committed, so this is the only copy left.
+func (c *consumer) quarantine(ctx context.Context, kafkaMsg *kafka.Message, handleErr error) {
+	c.logger.Errorw("failed to handle game message, quarantining it", "partition", kafkaMsg.Partition,
+		"offset", kafkaMsg.Offset, "error", handleErr)
+
+	// The handler may have failed because we're shutting down, the message must still be kept
+	ctx = context.WithoutCancel(ctx)
+	err := c.repo.QuarantineMessage(ctx, model.NewQuarantinedMessage(kafkaMsg, handleErr))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		c.logger.Errorw("failed to quarantine message", "partition", kafkaMsg.Partition, "offset", kafkaMsg.Offset,
+			"value", kafkaMsg.Value, "error", err)
+	}
+}
+
+func (c *consumer) handleGameStartMessage(ctx context.Context, kafkaMsg *kafka.Message, uncastMsg proto.Message) error {
 	m := uncastMsg.(*gametracker.GameStartMessage)
 	commonData := m.CommonData
+	position := messagePosition(kafkaMsg)
 
 	id, err := primitive.ObjectIDFromHex(commonData.GameId)
 	if err != nil {
-		c.logger.Errorw("failed to parse game id", "gameId", commonData.GameId)
-		return
+		return fmt.Errorf("failed to parse game id %s: %w", commonData.GameId, err)
 	}
 
 	players, err := model.BasicPlayersFromProto(commonData.Players)
 	if err != nil {
-		c.logger.Errorw("failed to parse players", "players", commonData.Players)
-		return
+		return fmt.Errorf("failed to parse players: %w", err)
 	}
 
-	liveGame := &model.LiveGame{
+	startGame := &model.LiveGame{
 		Game: &model.Game{
 			Id:         id,
 			GameModeId: commonData.GameModeId,
 			ServerId:   commonData.ServerId,
 			StartTime:  utils.Pointer(m.StartTime.AsTime()),
 			Players:    players,
+			MapId:      m.MapId,
 		},
 		LastUpdated: time.Now(),
+		LastMessage: position,
 	}
 
-	if err := c.liveHandler.handle(m.Content, liveGame); err != nil {
-		c.logger.Errorw("failed to handle game content", "game", commonData.GameId, "content", m.Content)
-		return
+	if err := c.parseLiveContent(m.Content, startGame); err != nil {
+		return fmt.Errorf("failed to handle game content: %w", err)
+	}
+
+	liveGame, err := c.getLiveGame(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if liveGame != nil {
+		// An update was processed before the start, so only fill in what the update couldn't know
+		if liveGame.IsStale(position) && liveGame.StartTime != nil {
+			c.logger.Debugw("ignoring duplicate game start", "gameId", id.Hex(), "position", position)
+			return nil
+		}
+
+		liveGame.StartTime = startGame.StartTime
+		liveGame.MergeMetadata(startGame.Game)
+		if liveGame.TeamData == nil {
+			liveGame.TeamData = startGame.TeamData
+		}
+		if liveGame.GameData == nil && startGame.GameData != nil {
+			liveGame.SetGameData(startGame.GameData)
+		}
+	} else {
+		// The finish may have been processed before the start, or the start may be redelivered after the finish
+		finished, err := c.repo.HistoricGameExists(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to check for historic game: %w", err)
+		}
+		if finished {
+			c.logger.Debugw("ignoring game start of finished game", "gameId", id.Hex())
+			return nil
+		}
+
+		liveGame = startGame
+	}
+
+	var timelineEntry *model.TimelineEntry
+	if liveGame == startGame {
+		timelineEntry = c.newTimelineEntry(model.TimelineState{}, liveGame, position)
 	}
 
 	if err := c.repo.SaveLiveGame(ctx, liveGame); err != nil {
-		c.logger.Errorw("failed to save live game", "game", liveGame, "error", err)
-		return
+		return fmt.Errorf("failed to save live game: %w", err)
 	}
+
+	c.appendTimelineEntry(ctx, liveGame, timelineEntry)
+
+	return nil
 }
 
-func (c *consumer) handleGameUpdateMessage(ctx context.Context, _ *kafka.Message, uncastMsg proto.Message) {
+func (c *consumer) handleGameUpdateMessage(ctx context.Context, kafkaMsg *kafka.Message, uncastMsg proto.Message) error {
 	m := uncastMsg.(*gametracker.GameUpdateMessage)
 	commonData := m.CommonData
+	position := messagePosition(kafkaMsg)
 
 	id, err := primitive.ObjectIDFromHex(commonData.GameId)
 	if err != nil {
-		c.logger.Errorw("failed to parse game id", "gameId", commonData.GameId)
-		return
+		return fmt.Errorf("failed to parse game id %s: %w", commonData.GameId, err)
 	}
 
-	liveGame, err := c.repo.GetLiveGame(ctx, id)
+	liveGame, err := c.getLiveGame(ctx, id)
 	if err != nil {
-		c.logger.Errorw("failed to get live game", "gameId", id.Hex(), err)
-		return
+		return err
 	}
 
+	if liveGame == nil {
+		finished, err := c.repo.HistoricGameExists(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to check for historic game: %w", err)
+		}
+		if finished {
+			c.logger.Debugw("ignoring game update of finished game", "gameId", id.Hex())
+			return nil
+		}
+
+		// The start hasn't been processed yet, create the game from the update and let the start fill in the rest
+		liveGame = &model.LiveGame{
+			Game: &model.Game{
+				Id:         id,
+				GameModeId: commonData.GameModeId,
+				ServerId:   commonData.ServerId,
+			},
+		}
+	} else if liveGame.IsStale(position) {
+		c.logger.Debugw("ignoring stale game update", "gameId", id.Hex(), "position", position, "lastPosition", liveGame.LastMessage)
+		return nil
+	}
+
+	timelineState := model.CaptureTimelineState(liveGame.Game)
+
 	// common data start
 
 	players, err := model.BasicPlayersFromProto(commonData.Players)
 	if err != nil {
-		c.logger.Errorw("failed to parse players", "players", commonData.Players)
-		return
+		return fmt.Errorf("failed to parse players: %w", err)
 	}
 
 	liveGame.Players = players
 	liveGame.LastUpdated = time.Now()
+	liveGame.LastMessage = position
 
 	// common data end
 
-	if err := c.liveHandler.handle(m.Content, liveGame); err != nil {
-		c.logger.Errorw("failed to handle game content", "game", commonData.GameId, "content", m.Content)
-		return
+	if err := c.parseLiveContent(m.Content, liveGame); err != nil {
+		return fmt.Errorf("failed to handle game content: %w", err)
 	}
 
+	// The entry is created before saving as it counts towards the game's timeline entries
+	timelineEntry := c.newTimelineEntry(timelineState, liveGame, position)
+
 	if err := c.repo.SaveLiveGame(ctx, liveGame); err != nil {
-		c.logger.Errorw("failed to save live game", "game", liveGame, "error", err)
+		return fmt.Errorf("failed to save live game: %w", err)
 	}
+
+	c.appendTimelineEntry(ctx, liveGame, timelineEntry)
+
+	return nil
 }
 
-func (c *consumer) handleGameFinishMessage(ctx context.Context, _ *kafka.Message, uncastMsg proto.Message) {
+// newTimelineEntry returns what changed in the game, or nil if nothing changed or timelines are disabled
+func (c *consumer) newTimelineEntry(before model.TimelineState, g *model.LiveGame, position *model.MessagePosition) *model.TimelineEntry {
+	if !c.timelineCfg.Enabled {
+		return nil
+	}
+
+	keyframe := g.TimelineEntries%model.TimelineKeyframeInterval == 0
+	entry := model.NewTimelineEntry(before, g.Game, position.Time, keyframe)
+	if entry != nil {
+		g.TimelineEntries++
+	}
+
+	return entry
+}
+
+// appendTimelineEntry appends the entry to the game's timeline, if there is one
+func (c *consumer) appendTimelineEntry(ctx context.Context, g *model.LiveGame, entry *model.TimelineEntry) {
+	if entry == nil {
+		return
+	}
+
+	if err := c.repo.AppendTimelineEntry(ctx, g.Id, entry, c.timelineCfg.MaxEntries); err != nil {
+		c.logger.Errorw("failed to append timeline entry", "gameId", g.Id.Hex(), "error", err)
+	}
+}
+
+func (c *consumer) handleGameFinishMessage(ctx context.Context, _ *kafka.Message, uncastMsg proto.Message) error {
 	m := uncastMsg.(*gametracker.GameFinishMessage)
 	commonData := m.CommonData
 
 	id, err := primitive.ObjectIDFromHex(commonData.GameId)
 	if err != nil {
-		c.logger.Errorw("failed to parse game id", "gameId", commonData.GameId)
-		return
+		return fmt.Errorf("failed to parse game id %s: %w", commonData.GameId, err)
 	}
 
-	liveGame, err := c.repo.GetLiveGame(ctx, id)
+	// The live game may be missing if the start and updates haven't been processed yet, or if this is a redelivery.
+	// It's also missing if the reaper abandoned the game, in which case the abandoned game holds what was known.
+	liveGame, err := c.getLiveGame(ctx, id)
 	if err != nil {
-		c.logger.Errorw("failed to get live game", "game", id, "error", err)
-		return
+		return err
 	}
 
-	if err := c.repo.DeleteLiveGame(ctx, id); err != nil {
-		c.logger.Errorw("failed to delete live game", "game", id, "error", err)
-		return
+	var source *model.Game
+	if liveGame != nil {
+		source = liveGame.Game
+	} else if source, err = c.getAbandonedGame(ctx, id); err != nil {
+		return err
 	}
 
 	players, err := model.BasicPlayersFromProto(commonData.Players)
 	if err != nil {
-		c.logger.Errorw("failed to parse players", "players", commonData.Players)
-		return
+		return fmt.Errorf("failed to parse players: %w", err)
 	}
 
 	game := &model.HistoricGame{
@@ -180,73 +370,155 @@ func (c *consumer) handleGameFinishMessage(ctx context.Context, _ *kafka.Message
 			Id:         id,
 			GameModeId: commonData.GameModeId,
 			ServerId:   commonData.ServerId,
-			StartTime:  liveGame.StartTime,
 			Players:    players,
 		},
 		EndTime: m.EndTime.AsTime(),
+		Outcome: model.GameOutcomeFinished,
 	}
+	if source != nil {
+		game.StartTime = source.StartTime
+		game.TeamData = source.TeamData
+		game.MergeMetadata(source)
 
-	if err := c.historicHandler.handle(m.Content, game); err != nil {
-		c.logger.Errorw("failed to handle game content", "error", err, "game", commonData.GameId, "content", m.Content)
-		return
+		// Unhandled finish content of the same type replaces it
+		game.RawContent = source.RawContent
+
+		// Finish content replaces this if the game mode sends its final state
+		if dataSource, ok := source.GameData.(model.HistoricDataSource); ok {
+			game.SetGameData(dataSource.ToHistoricData())
+		}
 	}
 
+	unhandled, err := c.parsers.ParseHistoric(m.Content, game)
+	if err != nil {
+		return fmt.Errorf("failed to handle game content: %w", err)
+	}
+	c.warnUnhandled(unhandled, id, m.Content)
+
+	// The historic game is saved before the live game is deleted so a failure in between never loses the game.
+	// A duplicate means the finish was already processed, so stats must not be counted again,
+	// unless the duplicate is the game abandoned by the reaper, which never counted towards stats.
+	alreadyFinished := false
 	if err := c.repo.SaveHistoricGame(ctx, game); err != nil {
-		c.logger.Errorw("failed to save historic game", "game", game, "error", err)
+		if !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("failed to save historic game: %w", err)
+		}
+
+		replaced, err := c.repo.ReplaceAbandonedHistoricGame(ctx, game)
+		if err != nil {
+			return fmt.Errorf("failed to replace abandoned historic game: %w", err)
+		}
+		alreadyFinished = !replaced
 	}
-}
 
-type parserHandler[T model.IGame] struct {
-	logger *zap.SugaredLogger
+	if !alreadyFinished {
+		stats := model.PlayerStatsFromGame(game)
+		if err := c.repo.IncrementPlayerStats(ctx, stats); err != nil {
+			c.logger.Errorw("failed to increment player stats", "game", id.Hex(), "error", err)
+		}
 
-	parsers map[proto.Message]func(data proto.Message, game *T) error
-}
+		if err := c.repo.IncrementLeaderboardEntries(ctx, model.LeaderboardEntriesFromStats(stats, game.EndTime)); err != nil {
+			c.logger.Errorw("failed to increment leaderboard entries", "game", id.Hex(), "error", err)
+		}
 
-func (h *parserHandler[T]) handle(content []*anypb.Any, g *T) error {
-	unhandledIndexes := make([]bool, len(content)) // every index is false by default
+		if mapStats := model.MapStatsFromGame(game); mapStats != nil {
+			if err := c.repo.IncrementMapStats(ctx, mapStats); err != nil {
+				c.logger.Errorw("failed to increment map stats", "game", id.Hex(), "error", err)
+			}
+		}
+	} else {
+		c.logger.Debugw("ignoring duplicate game finish", "gameId", id.Hex())
 
-	for i, anyPb := range content {
-		anyFullName := strings.SplitAfter(anyPb.TypeUrl, "type.googleapis.com/")[1]
+		// Rewards, achievements and checks use the stored game so they match the stats that were counted
+		stored, err := c.repo.GetHistoricGame(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get historic game: %w", err)
+		}
+		game = stored
+	}
 
-		for key, parser := range h.parsers {
-			if anyFullName == string(key.ProtoReflect().Descriptor().FullName()) {
-				unmarshaled := key
-				if err := anyPb.UnmarshalTo(unmarshaled); err != nil {
-					return fmt.Errorf("failed to unmarshal game content: %w", err)
-				}
+	// Payouts track themselves, so this also pays out games whose finish failed after saving the historic game
+	if c.rewardPayer != nil {
+		if err := c.rewardPayer.PayOut(ctx, game); err != nil {
+			c.logger.Errorw("failed to pay out game rewards", "gameId", id.Hex(), "error", err)
+		}
+	}
 
-				if err := parser(unmarshaled, g); err != nil {
-					return fmt.Errorf("failed to parse game content: %w", err)
-				}
+	// Unlocks track themselves too
+	if c.achievements != nil {
+		if err := c.achievements.Evaluate(ctx, game); err != nil {
+			c.logger.Errorw("failed to evaluate achievements", "gameId", id.Hex(), "error", err)
+		}
+	}
 
-				unhandledIndexes[i] = true
-				break
-			}
+	// Reviews are unique per game and check, so this is safe to repeat too
+	if c.detector != nil {
+		if err := c.detector.Check(ctx, game); err != nil {
+			c.logger.Errorw("failed to run anomaly checks", "gameId", id.Hex(), "error", err)
 		}
+	}
 
-		for key, parser := range parsers.DualParsers {
-			if anyFullName == string(key.ProtoReflect().Descriptor().FullName()) {
-				unmarshaled := key
-				if err := anyPb.UnmarshalTo(unmarshaled); err != nil {
-					return fmt.Errorf("failed to unmarshal game content: %w", err)
-				}
+	if liveGame != nil {
+		if err := c.repo.DeleteLiveGame(ctx, id); err != nil {
+			c.logger.Errorw("failed to delete live game", "game", id, "error", err)
+		}
+	}
 
-				if err := parser(unmarshaled, (*g).GetGame()); err != nil {
-					return fmt.Errorf("failed to parse game content: %w", err)
-				}
+	return nil
+}
 
-				unhandledIndexes[i] = true
-				break
-			}
+// getLiveGame returns the live game, or nil if it doesn't exist
+func (c *consumer) getLiveGame(ctx context.Context, id primitive.ObjectID) (*model.LiveGame, error) {
+	liveGame, err := c.repo.GetLiveGame(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
 		}
+
+		return nil, fmt.Errorf("failed to get live game: %w", err)
 	}
 
-	// check every index has been processed and warn if not
-	for i, b := range unhandledIndexes {
-		if !b {
-			h.logger.Warnw("unhandled game content index", "index", i, "game", g, "content", content)
+	return liveGame, nil
+}
+
+// getAbandonedGame returns the game if the reaper abandoned it, or nil if it doesn't exist or finished normally
+func (c *consumer) getAbandonedGame(ctx context.Context, id primitive.ObjectID) (*model.Game, error) {
+	game, err := c.repo.GetHistoricGame(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
 		}
+
+		return nil, fmt.Errorf("failed to get historic game: %w", err)
+	}
+
+	if game.Outcome != model.GameOutcomeAbandoned {
+		return nil, nil
+	}
+
+	return game.Game, nil
+}
+
+func messagePosition(m *kafka.Message) *model.MessagePosition {
+	return &model.MessagePosition{
+		Time:      m.Time,
+		Partition: m.Partition,
+		Offset:    m.Offset,
 	}
+}
 
+func (c *consumer) parseLiveContent(content []*anypb.Any, g *model.LiveGame) error {
+	unhandled, err := c.parsers.ParseLive(content, g)
+	if err != nil {
+		return err
+	}
+
+	c.warnUnhandled(unhandled, g.Id, content)
 	return nil
 }
+
+func (c *consumer) warnUnhandled(unhandled []int, gameId primitive.ObjectID, content []*anypb.Any) {
+	for _, i := range unhandled {
+		c.logger.Debugw("stored unhandled game content as raw content", "index", i, "type", content[i].MessageName(), "gameId", gameId.Hex())
+	}
+}
@@ -0,0 +1,89 @@
+package kafka
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/emortalmc/proto-specs/gen/go/message/gametracker"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"testing"
+)
+
+type quarantineRepo struct {
+	repository.Repository
+
+	quarantined []*model.QuarantinedMessage
+}
+
+func (r *quarantineRepo) QuarantineMessage(_ context.Context, msg *model.QuarantinedMessage) error {
+	r.quarantined = append(r.quarantined, msg)
+	return nil
+}
+
+func TestConsumer_HandleMessage(t *testing.T) {
+	startType := string((&gametracker.GameStartMessage{}).ProtoReflect().Descriptor().FullName())
+	finishType := string((&gametracker.GameFinishMessage{}).ProtoReflect().Descriptor().FullName())
+
+	tests := []struct {
+		name      string
+		protoType string
+		value     []byte
+
+		wantHandled     bool
+		wantQuarantined bool
+	}{
+		{
+			name:        "handled message",
+			protoType:   startType,
+			wantHandled: true,
+		},
+		{
+			name:            "undecodable message",
+			protoType:       startType,
+			value:           []byte{0xff, 0xff, 0xff},
+			wantQuarantined: true,
+		},
+		{
+			name:      "undecodable message without a handler",
+			protoType: finishType,
+			value:     []byte{0xff, 0xff, 0xff},
+		},
+		{
+			name:      "unknown proto type",
+			protoType: "emortal.unknown.Message",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &quarantineRepo{}
+			c := newConsumer(zap.NewNop().Sugar(), repo, config.TimelineConfig{}, nil, nil, nil, nil)
+
+			handled := false
+			handlers := map[protoreflect.MessageType]messageHandler{
+				(&gametracker.GameStartMessage{}).ProtoReflect().Type(): func(context.Context, *kafka.Message, proto.Message) error {
+					handled = true
+					return nil
+				},
+			}
+
+			c.handleMessage(context.Background(), handlers, &kafka.Message{
+				Headers: []kafka.Header{{Key: "X-Proto-Type", Value: []byte(tt.protoType)}},
+				Value:   tt.value,
+			})
+
+			assert.Equal(t, tt.wantHandled, handled)
+			if tt.wantQuarantined && assert.Len(t, repo.quarantined, 1) {
+				assert.Equal(t, tt.protoType, repo.quarantined[0].ProtoType)
+				assert.Equal(t, tt.value, repo.quarantined[0].Value)
+			} else if !tt.wantQuarantined {
+				assert.Empty(t, repo.quarantined)
+			}
+		})
+	}
+}
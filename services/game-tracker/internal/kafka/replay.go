@@ -0,0 +1,89 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/achievements"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/anomaly"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/parsers"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/rewards"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ReplayQuarantined passes quarantined messages straight to the consumer's handlers. They aren't republished as the
+// games topic is also consumed by other services, which already handled them.
+// rewardPayer, achievementEngine and detector may be nil, as for NewConsumer.
+//
+// Messages are replayed oldest first with their original position, so a replayed update is skipped if a newer message
+// of the same game was handled since (see model.LiveGame.IsStale). Replaying stops at the first message that fails
+// again, which stays quarantined with every message after it.
+func ReplayQuarantined(ctx context.Context, logger *zap.SugaredLogger, repo repository.Repository,
+	timelineCfg config.TimelineConfig, rewardPayer rewards.Payer, achievementEngine achievements.Engine,
+	detector anomaly.Detector, registry *parsers.Registry, ids []primitive.ObjectID, dryRun bool) (int, error) {
+
+	messages, err := repo.ListQuarantinedMessages(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	if dryRun {
+		for _, msg := range messages {
+			logger.Infow("would replay quarantined message", "id", msg.Id.Hex(), "protoType", msg.ProtoType,
+				"partition", msg.Partition, "offset", msg.Offset, "error", msg.Error)
+		}
+		return len(messages), nil
+	}
+
+	c := newConsumer(logger, repo, timelineCfg, rewardPayer, achievementEngine, detector, registry)
+	handlers := c.handlers()
+
+	replayed := 0
+	for _, msg := range messages {
+		if msg.ReplayedAt != nil {
+			logger.Warnw("skipping already replayed message", "id", msg.Id.Hex(), "replayedAt", msg.ReplayedAt)
+			continue
+		}
+
+		if err := replayMessage(ctx, handlers, msg); err != nil {
+			return replayed, fmt.Errorf("failed to replay message %s: %w", msg.Id.Hex(), err)
+		}
+
+		if err := repo.MarkQuarantinedMessageReplayed(ctx, msg.Id); err != nil {
+			return replayed, err
+		}
+
+		logger.Infow("replayed quarantined message", "id", msg.Id.Hex(), "protoType", msg.ProtoType)
+		replayed++
+	}
+
+	return replayed, nil
+}
+
+func replayMessage(ctx context.Context, handlers map[protoreflect.MessageType]messageHandler,
+	msg *model.QuarantinedMessage) error {
+
+	protoType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(msg.ProtoType))
+	if err != nil {
+		return fmt.Errorf("failed to find proto type %s: %w", msg.ProtoType, err)
+	}
+
+	handler, ok := handlers[protoType]
+	if !ok {
+		return fmt.Errorf("no handler for proto type %s", msg.ProtoType)
+	}
+
+	uncastMsg := protoType.New().Interface()
+	if err := proto.Unmarshal(msg.Value, uncastMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal message: %w", err)
+	}
+
+	kafkaMsg := msg.KafkaMessage()
+	return handler(ctx, &kafkaMsg, uncastMsg)
+}
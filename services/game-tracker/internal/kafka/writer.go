@@ -0,0 +1,100 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/libraries/gametracker/gen/go/gametrackermsgpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"time"
+)
+
+// eventsTopic is separate from gamesTopic as that topic is only for messages sent by games
+const eventsTopic = "game-tracker-events"
+
+type Notifier interface {
+	GameAbandoned(ctx context.Context, game *model.LiveGame, reason gametrackermsgpb.GameAbandonedMessage_Reason) error
+	AchievementUnlocked(ctx context.Context, unlock *model.AchievementUnlock) error
+	ReviewCreated(ctx context.Context, review *model.Review) error
+}
+
+type kafkaNotifier struct {
+	w *kafka.Writer
+}
+
+func NewKafkaNotifier(ctx context.Context, wg *sync.WaitGroup, config config.KafkaConfig, logger *zap.SugaredLogger) Notifier {
+	w := &kafka.Writer{
+		Addr:         kafka.TCP(fmt.Sprintf("%s:%d", config.Host, config.Port)),
+		Topic:        eventsTopic,
+		Balancer:     &kafka.LeastBytes{},
+		Async:        true,
+		BatchTimeout: 50 * time.Millisecond,
+		ErrorLogger:  kafka.LoggerFunc(logger.Errorw),
+	}
+
+	wg.Add(1)
+	go func() {
+		<-ctx.Done()
+		if err := w.Close(); err != nil {
+			logger.Errorw("failed to close kafka writer", "error", err)
+		}
+		wg.Done()
+	}()
+
+	return &kafkaNotifier{w: w}
+}
+
+func (k *kafkaNotifier) GameAbandoned(ctx context.Context, game *model.LiveGame, reason gametrackermsgpb.GameAbandonedMessage_Reason) error {
+	var startTime *timestamppb.Timestamp
+	if game.StartTime != nil {
+		startTime = timestamppb.New(*game.StartTime)
+	}
+
+	return k.write(ctx, &gametrackermsgpb.GameAbandonedMessage{
+		GameId:      game.Id.Hex(),
+		GameModeId:  game.GameModeId,
+		ServerId:    game.ServerId,
+		Players:     model.BasicPlayersToProto(game.Players),
+		StartTime:   startTime,
+		LastUpdated: timestamppb.New(game.LastUpdated),
+		Reason:      reason,
+	})
+}
+
+func (k *kafkaNotifier) AchievementUnlocked(ctx context.Context, unlock *model.AchievementUnlock) error {
+	var badgeId *string
+	if unlock.BadgeId != "" {
+		badgeId = &unlock.BadgeId
+	}
+
+	return k.write(ctx, &gametrackerpb.AchievementUnlockedMessage{
+		PlayerId:      unlock.PlayerId.String(),
+		AchievementId: unlock.AchievementId,
+		GameModeId:    unlock.GameModeId,
+		GameId:        unlock.GameId.Hex(),
+		BadgeId:       badgeId,
+		UnlockedAt:    timestamppb.New(unlock.UnlockedAt),
+	})
+}
+
+func (k *kafkaNotifier) ReviewCreated(ctx context.Context, review *model.Review) error {
+	return k.write(ctx, &gametrackerpb.ReviewCreatedMessage{Review: review.ToProto()})
+}
+
+func (k *kafkaNotifier) write(ctx context.Context, pMsg proto.Message) error {
+	bytes, err := proto.Marshal(pMsg)
+	if err != nil {
+		return err
+	}
+
+	return k.w.WriteMessages(ctx, kafka.Message{
+		Headers: []kafka.Header{{Key: "X-Proto-Type", Value: []byte(pMsg.ProtoReflect().Descriptor().FullName())}},
+		Value:   bytes,
+	})
+}
@@ -0,0 +1,56 @@
+package leaderboard
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// RunRollover deletes leaderboard periods once they are no longer the current or previous period of their window.
+// New periods need no setup as entries are created when the first game of a period finishes, so this runs
+// at every UTC midnight, which is when every window's periods start.
+func RunRollover(ctx context.Context, wg *sync.WaitGroup, logger *zap.SugaredLogger, repo repository.Repository) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			rollover(ctx, logger, repo, time.Now())
+
+			now := time.Now()
+			nextDay := model.LeaderboardWindowDaily.NextPeriodStart(model.LeaderboardWindowDaily.PeriodStart(now))
+
+			timer := time.NewTimer(nextDay.Sub(now))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+}
+
+func rollover(ctx context.Context, logger *zap.SugaredLogger, repo repository.Repository, now time.Time) {
+	for _, window := range model.LeaderboardWindows {
+		if window == model.LeaderboardWindowAllTime {
+			continue
+		}
+
+		// Keep the previous period so its final standings can still be queried
+		keepFrom := window.PreviousPeriodStart(window.PeriodStart(now))
+
+		deleted, err := repo.DeleteLeaderboardPeriodsBefore(ctx, window, keepFrom)
+		if err != nil {
+			logger.Errorw("failed to delete old leaderboard periods", "window", window, "error", err)
+			continue
+		}
+
+		if deleted > 0 {
+			logger.Infow("deleted old leaderboard periods", "window", window, "before", keepFrom, "entries", deleted)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package leaderboard
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+type periodRepo struct {
+	repository.Repository
+
+	deletedBefore map[model.LeaderboardWindow]time.Time
+}
+
+func (r *periodRepo) DeleteLeaderboardPeriodsBefore(_ context.Context, window model.LeaderboardWindow,
+	periodStart time.Time) (int64, error) {
+
+	r.deletedBefore[window] = periodStart
+	return 0, nil
+}
+
+func TestRollover(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+
+		want map[model.LeaderboardWindow]time.Time
+	}{
+		{
+			name: "start of a month",
+			now:  time.Date(2024, time.March, 1, 0, 30, 0, 0, time.UTC), // Friday
+			want: map[model.LeaderboardWindow]time.Time{
+				model.LeaderboardWindowDaily:   time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+				model.LeaderboardWindowWeekly:  time.Date(2024, time.February, 19, 0, 0, 0, 0, time.UTC),
+				model.LeaderboardWindowMonthly: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "start of a year",
+			now:  time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), // Monday
+			want: map[model.LeaderboardWindow]time.Time{
+				model.LeaderboardWindowDaily:   time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC),
+				model.LeaderboardWindowWeekly:  time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC),
+				model.LeaderboardWindowMonthly: time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &periodRepo{deletedBefore: make(map[model.LeaderboardWindow]time.Time)}
+
+			rollover(context.Background(), zap.NewNop().Sugar(), repo, tt.now)
+			assert.Equal(t, tt.want, repo.deletedBefore)
+		})
+	}
+}
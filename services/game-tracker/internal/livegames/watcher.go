@@ -0,0 +1,233 @@
+package livegames
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// subscriberBuffer is how many batches of events a subscriber can fall behind before it is dropped
+const subscriberBuffer = 64
+
+var ErrNotReady = errors.New("live games not loaded yet")
+
+type EventType uint8
+
+const (
+	EventAdded EventType = iota
+	EventUpdated
+	EventRemoved
+)
+
+type Event struct {
+	Type EventType
+	// Game is the current state of the game, or the last known state if it was removed
+	Game *model.LiveGame
+}
+
+// Watcher tracks the live games for subscribers that want to be told about changes.
+//
+// Live games are split between the replicas consuming game messages, so the watcher follows a change stream of the
+// live game collection rather than the messages this replica consumes. Each change only carries the game that
+// changed. A game that can't be decoded is logged and skipped, it doesn't affect the other games.
+type Watcher struct {
+	logger     *zap.SugaredLogger
+	repo       repository.Repository
+	retryDelay time.Duration
+
+	// lock is held while changing games so a subscriber's snapshot and its events never overlap
+	lock  sync.Mutex
+	ready bool
+	games map[primitive.ObjectID]*model.LiveGame
+	subs  map[*Subscription]struct{}
+}
+
+type Subscription struct {
+	w *Watcher
+
+	// Events receives batches of changes. It is closed if the subscriber falls too far behind.
+	Events <-chan []Event
+	events chan []Event
+}
+
+func NewWatcher(logger *zap.SugaredLogger, repo repository.Repository, retryDelay time.Duration) *Watcher {
+	return &Watcher{
+		logger:     logger,
+		repo:       repo,
+		retryDelay: retryDelay,
+
+		games: make(map[primitive.ObjectID]*model.LiveGame),
+		subs:  make(map[*Subscription]struct{}),
+	}
+}
+
+func (w *Watcher) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for {
+			err := w.watch(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			w.logger.Errorw("failed to watch live games, retrying", "retryDelay", w.retryDelay, "error", err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.retryDelay):
+			}
+		}
+	}()
+}
+
+// Subscribe returns the current live games and a subscription to their changes from then on.
+// The subscription must be closed once it is no longer used.
+func (w *Watcher) Subscribe() ([]*model.LiveGame, *Subscription, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if !w.ready {
+		return nil, nil, ErrNotReady
+	}
+
+	games := make([]*model.LiveGame, 0, len(w.games))
+	for _, g := range w.games {
+		games = append(games, g)
+	}
+
+	events := make(chan []Event, subscriberBuffer)
+	sub := &Subscription{w: w, Events: events, events: events}
+	w.subs[sub] = struct{}{}
+
+	return games, sub, nil
+}
+
+func (s *Subscription) Close() {
+	s.w.lock.Lock()
+	defer s.w.lock.Unlock()
+
+	if _, ok := s.w.subs[s]; ok {
+		delete(s.w.subs, s)
+		close(s.events)
+	}
+}
+
+// watch opens the change stream, loads the live games and then applies changes until the stream fails.
+// The stream is opened first so no change made while loading is missed.
+func (w *Watcher) watch(ctx context.Context) error {
+	stream, err := w.repo.WatchLiveGames(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stream.Close(context.Background()); err != nil {
+			w.logger.Warnw("failed to close live game stream", "error", err)
+		}
+	}()
+
+	games := make(map[primitive.ObjectID]*model.LiveGame)
+	err = w.repo.ForEachLiveGame(ctx, nil, nil, func(change repository.LiveGameChange) error {
+		if change.Err != nil {
+			w.logger.Errorw("skipping live game that failed to decode", "gameId", change.Id.Hex(), "error", change.Err)
+			return nil
+		}
+
+		games[change.Id] = change.Game
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load live games: %w", err)
+	}
+	w.replace(games)
+
+	for {
+		change, err := stream.Next(ctx)
+		if err != nil {
+			return err
+		}
+
+		if change.Err != nil {
+			// The last state that could be decoded is kept until the game changes again
+			w.logger.Errorw("skipping live game change that failed to decode", "gameId", change.Id.Hex(), "error", change.Err)
+			continue
+		}
+
+		w.apply(change)
+	}
+}
+
+// replace swaps in the games loaded when the stream is (re)opened, sending subscribers whatever changed while
+// the stream was down. This is the only time whole games are compared.
+func (w *Watcher) replace(games map[primitive.ObjectID]*model.LiveGame) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	var events []Event
+	for id, g := range games {
+		previous, ok := w.games[id]
+		if !ok {
+			events = append(events, Event{Type: EventAdded, Game: g})
+		} else if !reflect.DeepEqual(previous, g) {
+			events = append(events, Event{Type: EventUpdated, Game: g})
+		}
+	}
+	for id, g := range w.games {
+		if _, ok := games[id]; !ok {
+			events = append(events, Event{Type: EventRemoved, Game: g})
+		}
+	}
+
+	w.games = games
+	w.ready = true
+
+	if len(events) > 0 {
+		w.publish(events)
+	}
+}
+
+func (w *Watcher) apply(change repository.LiveGameChange) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	previous, ok := w.games[change.Id]
+
+	var event Event
+	switch {
+	case change.Game == nil && !ok:
+		// Already removed, e.g. an update read after the game was deleted followed by its delete
+		return
+	case change.Game == nil:
+		delete(w.games, change.Id)
+		event = Event{Type: EventRemoved, Game: previous}
+	case ok:
+		w.games[change.Id] = change.Game
+		event = Event{Type: EventUpdated, Game: change.Game}
+	default:
+		w.games[change.Id] = change.Game
+		event = Event{Type: EventAdded, Game: change.Game}
+	}
+
+	w.publish([]Event{event})
+}
+
+// publish must be called with the lock held
+func (w *Watcher) publish(events []Event) {
+	for sub := range w.subs {
+		select {
+		case sub.events <- events:
+		default:
+			// Dropping the subscriber is better than sending it an inconsistent view by skipping events
+			delete(w.subs, sub)
+			close(sub.events)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package parsers
 
 import (
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
 	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/gametracker"
 	"google.golang.org/protobuf/proto"
@@ -36,3 +37,17 @@ func parseGameFinishWinnerData(m proto.Message, g *model.HistoricGame) error {
 
 	return nil
 }
+
+func parseGameMetadata(m proto.Message, g *model.Game) error {
+	cast := m.(*gametrackerpb.CommonGameMetadata)
+
+	if cast.MapId != nil {
+		g.MapId = cast.MapId
+	}
+	if cast.MatchId != nil {
+		g.MatchId = cast.MatchId
+	}
+	g.Private = cast.Private
+
+	return nil
+}
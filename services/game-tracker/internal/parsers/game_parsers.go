@@ -2,6 +2,7 @@ package parsers
 
 import (
 	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
 	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/gametracker"
 	"google.golang.org/protobuf/proto"
@@ -17,6 +18,12 @@ func handleTowerDefenceStartData(m proto.Message, g *model.LiveGame) error {
 func handleTowerDefenceUpdateData(m proto.Message, g *model.LiveGame) error {
 	cast := m.(*pbmodel.TowerDefenceUpdateData)
 
+	// The update may be processed before the start if messages arrive out of order
+	if g.GameData == nil {
+		g.SetGameData(model.CreateLiveTowerDefenceDataFromUpdate(cast))
+		return nil
+	}
+
 	(g.GameData).(*model.LiveTowerDefenceData).Update(cast)
 
 	return nil
@@ -25,7 +32,7 @@ func handleTowerDefenceUpdateData(m proto.Message, g *model.LiveGame) error {
 func handleTowerDefenceFinishData(m proto.Message, g *model.HistoricGame) error {
 	cast := m.(*pbmodel.TowerDefenceFinishData)
 
-	g.GameData = model.CreateHistoricTowerDefenceDataFromFinish(cast)
+	g.SetGameData(model.CreateHistoricTowerDefenceDataFromFinish(cast))
 
 	return nil
 }
@@ -61,7 +68,92 @@ func handleBlockSumoFinishData(m proto.Message, g *model.HistoricGame) error {
 		return fmt.Errorf("failed to create historic block sumo data: %w", err)
 	}
 
-	g.GameData = data
+	g.SetGameData(data)
+
+	return nil
+}
+
+// Minesweeper
+
+func handleMinesweeperStartData(m proto.Message, g *model.LiveGame) error {
+	cast := m.(*pbmodel.MinesweeperStartData)
+	g.SetGameData(model.CreateLiveMinesweeperDataFromStart(cast))
+
+	return nil
+}
+
+func handleMinesweeperUpdateData(m proto.Message, g *model.LiveGame) error {
+	cast := m.(*pbmodel.MinesweeperUpdateData)
+
+	if g.GameData == nil {
+		data := &model.LiveMinesweeperData{}
+		data.Update(cast)
+		g.SetGameData(data)
+		return nil
+	}
+
+	g.GameData.(*model.LiveMinesweeperData).Update(cast)
+
+	return nil
+}
+
+func handleMinesweeperFinishData(m proto.Message, g *model.HistoricGame) error {
+	cast := m.(*pbmodel.MinesweeperFinishData)
+
+	// The board is kept from the live game, the finish only has the winners
+	if cast.WinnerData != nil {
+		return parseGameFinishWinnerData(cast.WinnerData, g)
+	}
+
+	return nil
+}
+
+// Marathon
+
+func handleMarathonUpdateData(m proto.Message, g *model.LiveGame) error {
+	cast := m.(*pbmodel.MarathonUpdateData)
+
+	if g.GameData == nil {
+		g.SetGameData(model.CreateLiveMarathonDataFromUpdate(cast))
+		return nil
+	}
+
+	g.GameData.(*model.LiveMarathonData).Update(cast)
+
+	return nil
+}
+
+func handleMarathonFinishData(m proto.Message, g *model.HistoricGame) error {
+	cast := m.(*pbmodel.MarathonFinishData)
+	g.SetGameData(model.CreateHistoricMarathonDataFromFinish(cast))
+
+	return nil
+}
+
+// Parkour Tag
+
+func handleParkourTagUpdateData(m proto.Message, g *model.LiveGame) error {
+	cast := m.(*gametrackerpb.ParkourTagUpdateData)
+
+	data, err := model.CreateLiveParkourTagDataFromUpdate(cast)
+	if err != nil {
+		return fmt.Errorf("failed to create live parkour tag data: %w", err)
+	}
+
+	g.SetGameData(data)
+
+	return nil
+}
+
+func handleParkourTagFinishData(m proto.Message, g *model.HistoricGame) error {
+	cast := m.(*gametrackerpb.ParkourTagFinishData)
+
+	data, err := model.CreateHistoricParkourTagDataFromFinish(cast)
+	if err != nil {
+		return fmt.Errorf("failed to create historic parkour tag data: %w", err)
+	}
+
+	g.SetGameData(data)
 
 	return nil
 }
@@ -1,28 +1,110 @@
 package parsers
 
 import (
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
 	pbmodel "github.com/emortalmc/proto-specs/gen/go/model/gametracker"
-	"google.golang.org/protobuf/proto"
 )
 
-var DualParsers = map[proto.Message]func(data proto.Message, g *model.Game) error{
-	// Common
-	&pbmodel.CommonGameTeamData{}: parseGameTeamData,
-}
+// NewDefaultRegistry creates a registry with the parsers of every game mode the game tracker knows,
+// registering their game data types with the model.
+func NewDefaultRegistry() *Registry {
+	registry := NewRegistry()
+	for _, reg := range defaultRegistrations() {
+		registry.Register(reg)
+	}
 
-var LiveParsers = map[proto.Message]func(data proto.Message, g *model.LiveGame) error{
-	// TowerDefence
-	&pbmodel.TowerDefenceStartData{}:  handleTowerDefenceStartData,
-	&pbmodel.TowerDefenceUpdateData{}: handleTowerDefenceUpdateData,
-	&pbmodel.BlockSumoUpdateData{}:    handleBlockSumoUpdateData,
+	return registry
 }
 
-var HistoricParsers = map[proto.Message]func(data proto.Message, g *model.HistoricGame) error{
-	// Common
-	&pbmodel.CommonGameFinishWinnerData{}: parseGameFinishWinnerData,
+// RegisterDefaultDataTypes registers the game data types of every game mode the game tracker knows with the model,
+// for programs that read games without parsing game content.
+func RegisterDefaultDataTypes() {
+	for _, reg := range defaultRegistrations() {
+		for id, example := range reg.DataTypes {
+			model.RegisterDataType(id, example)
+		}
+	}
+}
 
-	// TowerDefence
-	&pbmodel.TowerDefenceFinishData{}: handleTowerDefenceFinishData,
-	&pbmodel.BlockSumoFinishData{}:    handleBlockSumoFinishData,
+func defaultRegistrations() []Registration {
+	return []Registration{
+		{
+			Name: "common",
+			Dual: []Parser[*model.Game]{
+				{Message: &pbmodel.CommonGameTeamData{}, Parse: parseGameTeamData},
+				{Message: &gametrackerpb.CommonGameMetadata{}, Parse: parseGameMetadata},
+			},
+			Historic: []Parser[*model.HistoricGame]{
+				{Message: &pbmodel.CommonGameFinishWinnerData{}, Parse: parseGameFinishWinnerData},
+			},
+		},
+		{
+			Name: "tower_defence",
+			DataTypes: map[int32]interface{}{
+				1: &model.LiveTowerDefenceData{},
+				3: &model.HistoricTowerDefenceData{},
+			},
+			Live: []Parser[*model.LiveGame]{
+				{Message: &pbmodel.TowerDefenceStartData{}, Parse: handleTowerDefenceStartData},
+				{Message: &pbmodel.TowerDefenceUpdateData{}, Parse: handleTowerDefenceUpdateData},
+			},
+			Historic: []Parser[*model.HistoricGame]{
+				{Message: &pbmodel.TowerDefenceFinishData{}, Parse: handleTowerDefenceFinishData},
+			},
+		},
+		{
+			Name: "block_sumo",
+			DataTypes: map[int32]interface{}{
+				2: &model.LiveBlockSumoData{},
+				4: &model.HistoricBlockSumoData{},
+			},
+			Live: []Parser[*model.LiveGame]{
+				{Message: &pbmodel.BlockSumoUpdateData{}, Parse: handleBlockSumoUpdateData},
+			},
+			Historic: []Parser[*model.HistoricGame]{
+				{Message: &pbmodel.BlockSumoFinishData{}, Parse: handleBlockSumoFinishData},
+			},
+		},
+		{
+			Name: "minesweeper",
+			DataTypes: map[int32]interface{}{
+				5: &model.LiveMinesweeperData{},
+				6: &model.HistoricMinesweeperData{},
+			},
+			Live: []Parser[*model.LiveGame]{
+				{Message: &pbmodel.MinesweeperStartData{}, Parse: handleMinesweeperStartData},
+				{Message: &pbmodel.MinesweeperUpdateData{}, Parse: handleMinesweeperUpdateData},
+			},
+			Historic: []Parser[*model.HistoricGame]{
+				{Message: &pbmodel.MinesweeperFinishData{}, Parse: handleMinesweeperFinishData},
+			},
+		},
+		{
+			Name: "marathon",
+			DataTypes: map[int32]interface{}{
+				7: &model.LiveMarathonData{},
+				8: &model.HistoricMarathonData{},
+			},
+			Live: []Parser[*model.LiveGame]{
+				{Message: &pbmodel.MarathonUpdateData{}, Parse: handleMarathonUpdateData},
+			},
+			Historic: []Parser[*model.HistoricGame]{
+				{Message: &pbmodel.MarathonFinishData{}, Parse: handleMarathonFinishData},
+			},
+		},
+		{
+			Name: "parkour_tag",
+			DataTypes: map[int32]interface{}{
+				9:  &model.LiveParkourTagData{},
+				10: &model.HistoricParkourTagData{},
+			},
+			Live: []Parser[*model.LiveGame]{
+				{Message: &gametrackerpb.ParkourTagUpdateData{}, Parse: handleParkourTagUpdateData},
+			},
+			Historic: []Parser[*model.HistoricGame]{
+				{Message: &gametrackerpb.ParkourTagFinishData{}, Parse: handleParkourTagFinishData},
+			},
+		},
+	}
 }
@@ -0,0 +1,135 @@
+package parsers
+
+import (
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Parser parses one type of game content into a game
+type Parser[T any] struct {
+	// Message is only used for its type, a new message is created for every parse
+	Message proto.Message
+	Parse   func(data proto.Message, g T) error
+}
+
+// Registration is everything needed to track the content of a game mode
+type Registration struct {
+	Name string
+
+	// DataTypes are the types the parsers store in model.Game.GameData, keyed by the id they are stored with.
+	// Ids are persisted so they must never be changed or reused.
+	DataTypes map[int32]interface{}
+
+	Live     []Parser[*model.LiveGame]
+	Historic []Parser[*model.HistoricGame]
+	// Dual parsers are used for both live and historic games
+	Dual []Parser[*model.Game]
+}
+
+type registeredParser[T any] struct {
+	messageType protoreflect.MessageType
+	parse       func(data proto.Message, g T) error
+}
+
+type Registry struct {
+	live     map[protoreflect.FullName]registeredParser[*model.LiveGame]
+	historic map[protoreflect.FullName]registeredParser[*model.HistoricGame]
+	dual     map[protoreflect.FullName]registeredParser[*model.Game]
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		live:     make(map[protoreflect.FullName]registeredParser[*model.LiveGame]),
+		historic: make(map[protoreflect.FullName]registeredParser[*model.HistoricGame]),
+		dual:     make(map[protoreflect.FullName]registeredParser[*model.Game]),
+	}
+}
+
+// Register adds the parsers and data types of a registration. It panics if a content type is registered twice.
+// Registries are not safe for concurrent registration, so all registrations must happen before parsing.
+func (r *Registry) Register(reg Registration) {
+	for id, example := range reg.DataTypes {
+		model.RegisterDataType(id, example)
+	}
+
+	for _, p := range reg.Dual {
+		r.checkNotRegistered(reg.Name, p.Message)
+		r.dual[p.Message.ProtoReflect().Descriptor().FullName()] = toRegistered(p)
+	}
+	for _, p := range reg.Live {
+		r.checkNotRegistered(reg.Name, p.Message)
+		r.live[p.Message.ProtoReflect().Descriptor().FullName()] = toRegistered(p)
+	}
+	for _, p := range reg.Historic {
+		r.checkNotRegistered(reg.Name, p.Message)
+		r.historic[p.Message.ProtoReflect().Descriptor().FullName()] = toRegistered(p)
+	}
+}
+
+func (r *Registry) checkNotRegistered(regName string, msg proto.Message) {
+	name := msg.ProtoReflect().Descriptor().FullName()
+
+	_, dual := r.dual[name]
+	_, live := r.live[name]
+	_, historic := r.historic[name]
+	if dual || live || historic {
+		panic(fmt.Sprintf("%s: %s is already registered", regName, name))
+	}
+}
+
+func toRegistered[T any](p Parser[T]) registeredParser[T] {
+	return registeredParser[T]{messageType: p.Message.ProtoReflect().Type(), parse: p.Parse}
+}
+
+// ParseLive parses the content of a start or update message into the game.
+// Content without a registered parser is stored as raw content and its indexes are returned.
+func (r *Registry) ParseLive(content []*anypb.Any, g *model.LiveGame) ([]int, error) {
+	return parse(content, g, r.live, r.dual)
+}
+
+// ParseHistoric parses the content of a finish message into the game.
+// Content without a registered parser is stored as raw content and its indexes are returned.
+func (r *Registry) ParseHistoric(content []*anypb.Any, g *model.HistoricGame) ([]int, error) {
+	return parse(content, g, r.historic, r.dual)
+}
+
+func parse[T model.IGame](content []*anypb.Any, g T, parsers map[protoreflect.FullName]registeredParser[T],
+	dualParsers map[protoreflect.FullName]registeredParser[*model.Game]) ([]int, error) {
+
+	var unhandled []int
+
+	for i, anyPb := range content {
+		name := anyPb.MessageName()
+
+		if p, ok := parsers[name]; ok {
+			if err := parseOne(anyPb, p, g); err != nil {
+				return nil, err
+			}
+		} else if p, ok := dualParsers[name]; ok {
+			if err := parseOne(anyPb, p, g.GetGame()); err != nil {
+				return nil, err
+			}
+		} else {
+			g.GetGame().SetRawContent(model.RawContentFromAny(anyPb))
+			unhandled = append(unhandled, i)
+		}
+	}
+
+	return unhandled, nil
+}
+
+func parseOne[T any](anyPb *anypb.Any, p registeredParser[T], g T) error {
+	msg := p.messageType.New().Interface()
+	if err := anyPb.UnmarshalTo(msg); err != nil {
+		return fmt.Errorf("failed to unmarshal game content %s: %w", anyPb.MessageName(), err)
+	}
+
+	if err := p.parse(msg, g); err != nil {
+		return fmt.Errorf("failed to parse game content %s: %w", anyPb.MessageName(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/libraries/gametracker/gen/go/gametrackermsgpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/gameserver"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/kafka"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// leaseName is the lease that only lets one replica reap at a time
+const leaseName = "reaper"
+
+// Reaper moves live games that stopped receiving messages, e.g. because their server crashed, to the historic games.
+// Only the replica holding the reaper lease reaps, so a game is never abandoned twice.
+type Reaper struct {
+	logger   *zap.SugaredLogger
+	cfg      config.ReaperConfig
+	repo     repository.Repository
+	notifier kafka.Notifier
+
+	// instanceId identifies this replica as the holder of the lease
+	instanceId string
+
+	// serverChecker may be nil, in which case games are only abandoned after their timeout
+	serverChecker gameserver.Checker
+}
+
+func New(logger *zap.SugaredLogger, cfg config.ReaperConfig, repo repository.Repository, notifier kafka.Notifier,
+	instanceId string, serverChecker gameserver.Checker) *Reaper {
+
+	return &Reaper{
+		logger:        logger,
+		cfg:           cfg,
+		repo:          repo,
+		notifier:      notifier,
+		instanceId:    instanceId,
+		serverChecker: serverChecker,
+	}
+}
+
+func (r *Reaper) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.reap(ctx)
+			}
+		}
+	}()
+}
+
+func (r *Reaper) reap(ctx context.Context) {
+	// The lease outlives a missed run so it isn't passed between replicas every interval
+	held, err := r.repo.AcquireLease(ctx, leaseName, r.instanceId, 2*r.cfg.Interval)
+	if err != nil {
+		r.logger.Errorw("failed to acquire reaper lease", "error", err)
+		return
+	}
+	if !held {
+		return
+	}
+
+	// Games are collected first so abandoning them doesn't hold the cursor open
+	var games []*model.LiveGame
+	err = r.repo.ForEachLiveGame(ctx, nil, nil, func(change repository.LiveGameChange) error {
+		if change.Err != nil {
+			r.logger.Errorw("skipping live game that failed to decode", "gameId", change.Id.Hex(), "error", change.Err)
+			return nil
+		}
+
+		games = append(games, change.Game)
+		return nil
+	})
+	if err != nil {
+		r.logger.Errorw("failed to list live games", "error", err)
+		return
+	}
+
+	now := time.Now()
+	for _, game := range games {
+		reason, abandoned := r.checkGame(ctx, game, now)
+		if !abandoned {
+			continue
+		}
+
+		if err := r.abandon(ctx, game, reason); err != nil {
+			r.logger.Errorw("failed to abandon live game", "gameId", game.Id.Hex(), "error", err)
+		}
+	}
+}
+
+func (r *Reaper) checkGame(ctx context.Context, game *model.LiveGame, now time.Time) (gametrackermsgpb.GameAbandonedMessage_Reason, bool) {
+	if now.Sub(game.LastUpdated) > r.cfg.Timeout(game.GameModeId) {
+		return gametrackermsgpb.GameAbandonedMessage_TIMED_OUT, true
+	}
+
+	// Games without a server id can only time out
+	if r.serverChecker == nil || game.ServerId == "" {
+		return 0, false
+	}
+
+	// The game server may not be visible yet for very new games
+	created := game.LastUpdated
+	if game.StartTime != nil {
+		created = *game.StartTime
+	}
+	if now.Sub(created) < r.cfg.ServerGracePeriod {
+		return 0, false
+	}
+
+	exists, err := r.serverChecker.Exists(ctx, game.ServerId)
+	if err != nil {
+		r.logger.Warnw("failed to check if game server exists", "serverId", game.ServerId, "error", err)
+		return 0, false
+	}
+
+	return gametrackermsgpb.GameAbandonedMessage_SERVER_GONE, !exists
+}
+
+func (r *Reaper) abandon(ctx context.Context, game *model.LiveGame, reason gametrackermsgpb.GameAbandonedMessage_Reason) error {
+	// A duplicate key means the game was already moved, e.g. the finish message arrived while reaping.
+	// The live game is still deleted so it isn't reaped again.
+	err := r.repo.SaveHistoricGame(ctx, model.NewAbandonedGame(game))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	alreadyMoved := err != nil
+
+	if err := r.repo.DeleteLiveGame(ctx, game.Id); err != nil {
+		return err
+	}
+
+	if alreadyMoved {
+		return nil
+	}
+
+	r.logger.Infow("abandoned live game", "gameId", game.Id.Hex(), "gameModeId", game.GameModeId,
+		"serverId", game.ServerId, "reason", reason)
+
+	if err := r.notifier.GameAbandoned(ctx, game, reason); err != nil {
+		return fmt.Errorf("failed to send game abandoned message: %w", err)
+	}
+
+	return nil
+}
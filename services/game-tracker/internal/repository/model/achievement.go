@@ -0,0 +1,32 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// AchievementUnlock records that a player unlocked an achievement. It is created before the badge is granted,
+// so each player unlocks an achievement at most once.
+type AchievementUnlock struct {
+	PlayerId      uuid.UUID `bson:"playerId"`
+	AchievementId string    `bson:"achievementId"`
+
+	GameModeId string `bson:"gameModeId"`
+	// GameId is the game that unlocked the achievement
+	GameId primitive.ObjectID `bson:"gameId"`
+	// BadgeId is the badge granted for the achievement, if any
+	BadgeId    string    `bson:"badgeId,omitempty"`
+	UnlockedAt time.Time `bson:"unlockedAt"`
+
+	// Error is set if granting the badge failed
+	Error string `bson:"error,omitempty"`
+	// Attempts is how many times granting the badge failed
+	Attempts int `bson:"attempts,omitempty"`
+}
+
+// AchievementValueSource is implemented by historic game data with per player values that achievements can check,
+// in addition to the counters of PlayerCounterSource
+type AchievementValueSource interface {
+	AchievementValues(teams []*Team) map[uuid.UUID]map[string]int64
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/emortalmc/proto-specs/gen/go/model/gametracker"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 )
 
 type LiveBlockSumoData struct {
@@ -14,6 +15,30 @@ type HistoricBlockSumoData struct {
 	Scoreboard *BlockSumoScoreboard `bson:"scoreboard"`
 }
 
+func (d *LiveBlockSumoData) ToProto() proto.Message {
+	return &gametracker.BlockSumoUpdateData{Scoreboard: d.Scoreboard.ToProto()}
+}
+
+func (d *HistoricBlockSumoData) ToProto() proto.Message {
+	return &gametracker.BlockSumoFinishData{Scoreboard: d.Scoreboard.ToProto()}
+}
+
+func (d *HistoricBlockSumoData) PlayerCounters() map[uuid.UUID]map[string]int64 {
+	if d.Scoreboard == nil {
+		return nil
+	}
+
+	counters := make(map[uuid.UUID]map[string]int64, len(d.Scoreboard.Entries))
+	for id, e := range d.Scoreboard.Entries {
+		counters[id] = map[string]int64{
+			CounterKills:      int64(e.Kills),
+			CounterFinalKills: int64(e.FinalKills),
+		}
+	}
+
+	return counters
+}
+
 func CreateLiveBlockSumoDataFromUpdate(data *gametracker.BlockSumoUpdateData) (*LiveBlockSumoData, error) {
 	scoreboard, err := CreateBlockSumoScoreboard(data.Scoreboard)
 	if err != nil {
@@ -55,6 +80,23 @@ type BlockSumoScoreboard struct {
 	Entries map[uuid.UUID]*BlockSumoScoreboardEntry `bson:"entries"`
 }
 
+func (s *BlockSumoScoreboard) ToProto() *gametracker.BlockSumoScoreboard {
+	if s == nil {
+		return nil
+	}
+
+	entries := make(map[string]*gametracker.BlockSumoScoreboard_Entry, len(s.Entries))
+	for id, e := range s.Entries {
+		entries[id.String()] = &gametracker.BlockSumoScoreboard_Entry{
+			RemainingLives: e.RemainingLives,
+			Kills:          e.Kills,
+			FinalKills:     e.FinalKills,
+		}
+	}
+
+	return &gametracker.BlockSumoScoreboard{Entries: entries}
+}
+
 type BlockSumoScoreboardEntry struct {
 	RemainingLives int32 `bson:"remainingLives"`
 	Kills          int32 `bson:"kills"`
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type LeaderboardWindow string
+
+const (
+	LeaderboardWindowAllTime LeaderboardWindow = "allTime"
+	LeaderboardWindowMonthly LeaderboardWindow = "monthly"
+	LeaderboardWindowWeekly  LeaderboardWindow = "weekly"
+	LeaderboardWindowDaily   LeaderboardWindow = "daily"
+)
+
+var LeaderboardWindows = []LeaderboardWindow{
+	LeaderboardWindowAllTime,
+	LeaderboardWindowMonthly,
+	LeaderboardWindowWeekly,
+	LeaderboardWindowDaily,
+}
+
+// allTimePeriodStart is the period start used by the all-time window, which never resets
+var allTimePeriodStart = time.Unix(0, 0).UTC()
+
+// PeriodStart returns the start of the period containing t. Periods are in UTC, weeks start on Monday.
+func (w LeaderboardWindow) PeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	year, month, day := t.Date()
+
+	switch w {
+	case LeaderboardWindowMonthly:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	case LeaderboardWindowWeekly:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case LeaderboardWindowDaily:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	default:
+		return allTimePeriodStart
+	}
+}
+
+// NextPeriodStart returns the start of the period after the one starting at periodStart, or nil for the all-time window.
+func (w LeaderboardWindow) NextPeriodStart(periodStart time.Time) *time.Time {
+	var next time.Time
+
+	switch w {
+	case LeaderboardWindowMonthly:
+		next = periodStart.AddDate(0, 1, 0)
+	case LeaderboardWindowWeekly:
+		next = periodStart.AddDate(0, 0, 7)
+	case LeaderboardWindowDaily:
+		next = periodStart.AddDate(0, 0, 1)
+	default:
+		return nil
+	}
+
+	return &next
+}
+
+// PreviousPeriodStart returns the start of the period before the one starting at periodStart.
+// The all-time window has no previous period so its own start is returned.
+func (w LeaderboardWindow) PreviousPeriodStart(periodStart time.Time) time.Time {
+	switch w {
+	case LeaderboardWindowMonthly:
+		return periodStart.AddDate(0, -1, 0)
+	case LeaderboardWindowWeekly:
+		return periodStart.AddDate(0, 0, -7)
+	case LeaderboardWindowDaily:
+		return periodStart.AddDate(0, 0, -1)
+	default:
+		return periodStart
+	}
+}
+
+// LeaderboardMetric is the name of the LeaderboardEntry field a leaderboard is ranked by
+type LeaderboardMetric string
+
+const (
+	LeaderboardMetricWins        LeaderboardMetric = "wins"
+	LeaderboardMetricKills       LeaderboardMetric = "kills"
+	LeaderboardMetricGamesPlayed LeaderboardMetric = "gamesPlayed"
+)
+
+// LeaderboardEntry holds the totals of a player for one game mode in one period of a window
+type LeaderboardEntry struct {
+	GameModeId  string            `bson:"gameModeId"`
+	Window      LeaderboardWindow `bson:"window"`
+	PeriodStart time.Time         `bson:"periodStart"`
+	PlayerId    uuid.UUID         `bson:"playerId"`
+
+	Wins        int64 `bson:"wins"`
+	Kills       int64 `bson:"kills"`
+	GamesPlayed int64 `bson:"gamesPlayed"`
+}
+
+func (e *LeaderboardEntry) Score(metric LeaderboardMetric) int64 {
+	switch metric {
+	case LeaderboardMetricWins:
+		return e.Wins
+	case LeaderboardMetricKills:
+		return e.Kills
+	case LeaderboardMetricGamesPlayed:
+		return e.GamesPlayed
+	default:
+		return 0
+	}
+}
+
+// LeaderboardEntriesFromStats creates the entries for every window that the stats gained in a game ending at endTime count towards
+func LeaderboardEntriesFromStats(stats []*PlayerStats, endTime time.Time) []*LeaderboardEntry {
+	entries := make([]*LeaderboardEntry, 0, len(stats)*len(LeaderboardWindows))
+
+	for _, s := range stats {
+		for _, w := range LeaderboardWindows {
+			entries = append(entries, &LeaderboardEntry{
+				GameModeId:  s.GameModeId,
+				Window:      w,
+				PeriodStart: w.PeriodStart(endTime),
+				PlayerId:    s.PlayerId,
+				Wins:        s.Wins,
+				Kills:       s.Counters[CounterKills],
+				GamesPlayed: s.GamesPlayed,
+			})
+		}
+	}
+
+	return entries
+}
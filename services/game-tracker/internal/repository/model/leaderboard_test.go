@@ -0,0 +1,92 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLeaderboardWindow_Periods(t *testing.T) {
+	utc := func(year int, month time.Month, day int, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		window LeaderboardWindow
+		t      time.Time
+
+		wantStart    time.Time
+		wantNext     time.Time // zero if there is no next period
+		wantPrevious time.Time
+	}{
+		{
+			name:         "daily at the end of a month",
+			window:       LeaderboardWindowDaily,
+			t:            time.Date(2024, time.January, 31, 23, 59, 59, 0, time.UTC),
+			wantStart:    utc(2024, time.January, 31, 0),
+			wantNext:     utc(2024, time.February, 1, 0),
+			wantPrevious: utc(2024, time.January, 30, 0),
+		},
+		{
+			name:         "daily in another timezone",
+			window:       LeaderboardWindowDaily,
+			t:            time.Date(2024, time.January, 1, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+			wantStart:    utc(2023, time.December, 31, 0),
+			wantNext:     utc(2024, time.January, 1, 0),
+			wantPrevious: utc(2023, time.December, 30, 0),
+		},
+		{
+			name:         "weekly on a Sunday",
+			window:       LeaderboardWindowWeekly,
+			t:            utc(2024, time.January, 7, 23),
+			wantStart:    utc(2024, time.January, 1, 0),
+			wantNext:     utc(2024, time.January, 8, 0),
+			wantPrevious: utc(2023, time.December, 25, 0),
+		},
+		{
+			name:         "weekly at the start of a Monday",
+			window:       LeaderboardWindowWeekly,
+			t:            utc(2024, time.January, 8, 0),
+			wantStart:    utc(2024, time.January, 8, 0),
+			wantNext:     utc(2024, time.January, 15, 0),
+			wantPrevious: utc(2024, time.January, 1, 0),
+		},
+		{
+			name:         "monthly after a leap day",
+			window:       LeaderboardWindowMonthly,
+			t:            utc(2024, time.March, 31, 12),
+			wantStart:    utc(2024, time.March, 1, 0),
+			wantNext:     utc(2024, time.April, 1, 0),
+			wantPrevious: utc(2024, time.February, 1, 0),
+		},
+		{
+			name:         "monthly at the end of a year",
+			window:       LeaderboardWindowMonthly,
+			t:            utc(2023, time.December, 15, 12),
+			wantStart:    utc(2023, time.December, 1, 0),
+			wantNext:     utc(2024, time.January, 1, 0),
+			wantPrevious: utc(2023, time.November, 1, 0),
+		},
+		{
+			name:         "all time",
+			window:       LeaderboardWindowAllTime,
+			t:            utc(2024, time.January, 1, 0),
+			wantStart:    allTimePeriodStart,
+			wantPrevious: allTimePeriodStart,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := tt.window.PeriodStart(tt.t)
+			assert.Equal(t, tt.wantStart, start)
+			if next := tt.window.NextPeriodStart(start); tt.wantNext.IsZero() {
+				assert.Nil(t, next)
+			} else if assert.NotNil(t, next) {
+				assert.Equal(t, tt.wantNext, *next)
+			}
+			assert.Equal(t, tt.wantPrevious, tt.window.PreviousPeriodStart(start))
+		})
+	}
+}
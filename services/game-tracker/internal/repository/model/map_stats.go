@@ -0,0 +1,137 @@
+package model
+
+import (
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// MapStats are the totals of a map of a game mode, aggregated from finished public games.
+// Deleted games' map stats are kept as pruned map stats so that a stats rebuild still counts them.
+type MapStats struct {
+	GameModeId string `bson:"gameModeId"`
+	MapId      string `bson:"mapId"`
+
+	GamesPlayed int64 `bson:"gamesPlayed"`
+	// TimedGames is how many games had a start time, so were counted in TotalDuration
+	TimedGames    int64         `bson:"timedGames"`
+	TotalDuration time.Duration `bson:"totalDuration"`
+
+	// TeamColors are keyed by the decimal Team.Color. Only games with winner data are counted, so games without a
+	// result don't lower the win rate.
+	TeamColors map[string]*TeamColorStats `bson:"teamColors,omitempty"`
+}
+
+type TeamColorStats struct {
+	Games int64 `bson:"games"`
+	Wins  int64 `bson:"wins"`
+}
+
+// MapStatsFromGame creates the stats a map gained from a game, or nil if the game doesn't count towards map stats
+// because it has no map, was private or was abandoned.
+func MapStatsFromGame(g *HistoricGame) *MapStats {
+	if g.MapId == nil || g.Private || g.Outcome == GameOutcomeAbandoned {
+		return nil
+	}
+
+	s := &MapStats{
+		GameModeId:  g.GameModeId,
+		MapId:       *g.MapId,
+		GamesPlayed: 1,
+	}
+
+	if g.StartTime != nil && g.EndTime.After(*g.StartTime) {
+		s.TimedGames = 1
+		s.TotalDuration = g.EndTime.Sub(*g.StartTime)
+	}
+
+	if g.TeamData != nil && g.WinnerData != nil {
+		winners := make(map[uuid.UUID]bool, len(g.WinnerData.WinnerIds))
+		for _, id := range g.WinnerData.WinnerIds {
+			winners[id] = true
+		}
+
+		s.TeamColors = make(map[string]*TeamColorStats)
+		for _, t := range *g.TeamData {
+			key := strconv.Itoa(int(t.Color))
+			colorStats, ok := s.TeamColors[key]
+			if !ok {
+				colorStats = &TeamColorStats{}
+				s.TeamColors[key] = colorStats
+			}
+
+			colorStats.Games++
+			for _, id := range t.PlayerIds {
+				if winners[id] {
+					colorStats.Wins++
+					break
+				}
+			}
+		}
+	}
+
+	return s
+}
+
+// Add adds the totals of other, which must be of the same map, to s.
+func (s *MapStats) Add(other *MapStats) {
+	s.GamesPlayed += other.GamesPlayed
+	s.TimedGames += other.TimedGames
+	s.TotalDuration += other.TotalDuration
+
+	for color, otherColor := range other.TeamColors {
+		if s.TeamColors == nil {
+			s.TeamColors = make(map[string]*TeamColorStats)
+		}
+
+		colorStats, ok := s.TeamColors[color]
+		if !ok {
+			colorStats = &TeamColorStats{}
+			s.TeamColors[color] = colorStats
+		}
+		colorStats.Games += otherColor.Games
+		colorStats.Wins += otherColor.Wins
+	}
+}
+
+func (s *MapStats) AverageDuration() time.Duration {
+	if s.TimedGames == 0 {
+		return 0
+	}
+
+	return s.TotalDuration / time.Duration(s.TimedGames)
+}
+
+func (s *MapStats) ToProto() *gametrackerpb.MapStats {
+	teamColors := make([]*gametrackerpb.TeamColorStats, 0, len(s.TeamColors))
+	for key, colorStats := range s.TeamColors {
+		color, err := strconv.Atoi(key)
+		if err != nil {
+			continue // Only ever written from an int32 by MapStatsFromGame
+		}
+
+		var winRate float64
+		if colorStats.Games > 0 {
+			winRate = float64(colorStats.Wins) / float64(colorStats.Games)
+		}
+
+		teamColors = append(teamColors, &gametrackerpb.TeamColorStats{
+			Color:   int32(color),
+			Games:   colorStats.Games,
+			Wins:    colorStats.Wins,
+			WinRate: winRate,
+		})
+	}
+	sort.Slice(teamColors, func(i, j int) bool { return teamColors[i].Color < teamColors[j].Color })
+
+	return &gametrackerpb.MapStats{
+		GameModeId:      s.GameModeId,
+		MapId:           s.MapId,
+		GamesPlayed:     s.GamesPlayed,
+		AverageDuration: durationpb.New(s.AverageDuration()),
+		TeamColors:      teamColors,
+	}
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"github.com/emortalmc/proto-specs/gen/go/model/gametracker"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type MarathonRun struct {
+	// Distance is the score of the run
+	Distance  int32      `bson:"distance"`
+	StartTime time.Time  `bson:"startTime"`
+	EndTime   *time.Time `bson:"endTime,omitempty"`
+}
+
+func CreateMarathonRun(r *gametracker.MarathonRun) *MarathonRun {
+	run := &MarathonRun{
+		Distance:  r.Distance,
+		StartTime: r.StartTime.AsTime(),
+	}
+	if r.EndTime != nil {
+		endTime := r.EndTime.AsTime()
+		run.EndTime = &endTime
+	}
+
+	return run
+}
+
+func CreateMarathonRuns(runs []*gametracker.MarathonRun) []*MarathonRun {
+	parsed := make([]*MarathonRun, len(runs))
+	for i, r := range runs {
+		parsed[i] = CreateMarathonRun(r)
+	}
+
+	return parsed
+}
+
+func (r *MarathonRun) ToProto() *gametracker.MarathonRun {
+	return &gametracker.MarathonRun{
+		Distance:  r.Distance,
+		StartTime: timestamppb.New(r.StartTime),
+		EndTime:   timeToProto(r.EndTime),
+	}
+}
+
+func marathonRunsToProto(runs []*MarathonRun) []*gametracker.MarathonRun {
+	protoRuns := make([]*gametracker.MarathonRun, len(runs))
+	for i, r := range runs {
+		protoRuns[i] = r.ToProto()
+	}
+
+	return protoRuns
+}
+
+func bestMarathonDistance(runs []*MarathonRun) int32 {
+	var best int32
+	for _, r := range runs {
+		best = max(best, r.Distance)
+	}
+
+	return best
+}
+
+type LiveMarathonData struct {
+	CurrentTime   time.Time      `bson:"currentTime"`
+	CurrentRun    *MarathonRun   `bson:"currentRun,omitempty"`
+	CompletedRuns []*MarathonRun `bson:"completedRuns"`
+}
+
+func CreateLiveMarathonDataFromUpdate(data *gametracker.MarathonUpdateData) *LiveMarathonData {
+	d := &LiveMarathonData{}
+	d.Update(data)
+
+	return d
+}
+
+func (d *LiveMarathonData) Update(data *gametracker.MarathonUpdateData) {
+	d.CurrentTime = data.CurrentTime.AsTime()
+	d.CompletedRuns = CreateMarathonRuns(data.CompletedRuns)
+
+	d.CurrentRun = nil
+	if data.CurrentRun != nil {
+		d.CurrentRun = CreateMarathonRun(data.CurrentRun)
+	}
+}
+
+func (d *LiveMarathonData) ToProto() proto.Message {
+	var currentRun *gametracker.MarathonRun
+	if d.CurrentRun != nil {
+		currentRun = d.CurrentRun.ToProto()
+	}
+
+	return &gametracker.MarathonUpdateData{
+		CurrentTime:   timestamppb.New(d.CurrentTime),
+		CurrentRun:    currentRun,
+		CompletedRuns: marathonRunsToProto(d.CompletedRuns),
+	}
+}
+
+type HistoricMarathonData struct {
+	Runs []*MarathonRun `bson:"runs"`
+
+	// BestDistance is the highest distance of all runs, stored so games can be sorted by it
+	BestDistance int32 `bson:"bestDistance"`
+}
+
+func CreateHistoricMarathonDataFromFinish(data *gametracker.MarathonFinishData) *HistoricMarathonData {
+	runs := CreateMarathonRuns(data.Runs)
+
+	return &HistoricMarathonData{
+		Runs:         runs,
+		BestDistance: bestMarathonDistance(runs),
+	}
+}
+
+func (d *HistoricMarathonData) ToProto() proto.Message {
+	return &gametracker.MarathonFinishData{Runs: marathonRunsToProto(d.Runs)}
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/proto-specs/gen/go/model/gametracker"
+	"google.golang.org/protobuf/proto"
+)
+
+type LiveMinesweeperData struct {
+	TotalMines  int64 `bson:"totalMines"`
+	TotalBlocks int64 `bson:"totalBlocks"`
+
+	RemainingMines int32 `bson:"remainingMines"`
+	PlacedFlags    int32 `bson:"placedFlags"`
+	BlocksRevealed int32 `bson:"blocksRevealed"`
+}
+
+func CreateLiveMinesweeperDataFromStart(data *gametracker.MinesweeperStartData) *LiveMinesweeperData {
+	return &LiveMinesweeperData{
+		TotalMines:     data.TotalMines,
+		TotalBlocks:    data.TotalBlocks,
+		RemainingMines: int32(data.TotalMines),
+	}
+}
+
+func (d *LiveMinesweeperData) Update(data *gametracker.MinesweeperUpdateData) {
+	d.RemainingMines = data.RemainingMines
+	d.PlacedFlags = data.PlacedFlags
+	d.BlocksRevealed = data.BlocksRevealed
+}
+
+func (d *LiveMinesweeperData) ToProto() proto.Message {
+	return &gametracker.MinesweeperUpdateData{
+		RemainingMines: d.RemainingMines,
+		PlacedFlags:    d.PlacedFlags,
+		BlocksRevealed: d.BlocksRevealed,
+	}
+}
+
+// ToHistoricData keeps the final board, as the finish message only contains the winners
+func (d *LiveMinesweeperData) ToHistoricData() interface{} {
+	return &HistoricMinesweeperData{
+		TotalMines:     d.TotalMines,
+		TotalBlocks:    d.TotalBlocks,
+		RemainingMines: d.RemainingMines,
+		PlacedFlags:    d.PlacedFlags,
+		BlocksRevealed: d.BlocksRevealed,
+	}
+}
+
+type HistoricMinesweeperData struct {
+	TotalMines  int64 `bson:"totalMines"`
+	TotalBlocks int64 `bson:"totalBlocks"`
+
+	RemainingMines int32 `bson:"remainingMines"`
+	PlacedFlags    int32 `bson:"placedFlags"`
+	BlocksRevealed int32 `bson:"blocksRevealed"`
+}
+
+// ToProto returns the final board as there is no finish data with the board
+func (d *HistoricMinesweeperData) ToProto() proto.Message {
+	return &gametrackerpb.HistoricMinesweeperData{
+		TotalMines:     d.TotalMines,
+		TotalBlocks:    d.TotalBlocks,
+		RemainingMines: d.RemainingMines,
+		PlacedFlags:    d.PlacedFlags,
+		BlocksRevealed: d.BlocksRevealed,
+	}
+}
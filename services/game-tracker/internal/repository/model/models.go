@@ -2,36 +2,52 @@ package model
 
 import (
 	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/registrytypes"
-	"github.com/emortalmc/mono-services/services/game-tracker/internal/utils"
 	"github.com/emortalmc/proto-specs/gen/go/model/gametracker"
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/bsonrw"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"reflect"
 	"time"
 )
 
 type GameStage uint8
 
-const (
-	LiveTowerDefenceDataId int32 = 1
-	LiveBlockSumoDataId    int32 = 2
+var (
+	dataTypes   = make(map[int32]reflect.Type)
+	dataTypeIds = make(map[reflect.Type]int32)
 )
 
-var dataType = map[int32]interface{}{
-	LiveTowerDefenceDataId: &LiveTowerDefenceData{},
-	LiveBlockSumoDataId:    &LiveBlockSumoData{},
-}
+// RegisterDataType registers a GameData type under the id it is stored with, so ParseGameData can decode it.
+// Ids are persisted so they must never be changed or reused. Registering the same id and type again is a no-op.
+func RegisterDataType(id int32, example interface{}) {
+	if id == 0 {
+		panic("game data type id 0 is reserved for games without game data")
+	}
 
-func getDataType(example interface{}) int32 {
-	for k, v := range dataType {
-		if utils.IsSameType(example, v) {
-			return k
-		}
+	t := reflect.TypeOf(example)
+	if t == nil || t.Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("game data type %d must be registered with a pointer example", id))
 	}
 
-	return 0 // 0 is ignored by omitempty so it won't be put in the db
+	if existing, ok := dataTypes[id]; ok && existing != t {
+		panic(fmt.Sprintf("game data type id %d registered for both %s and %s", id, existing, t))
+	}
+	if existing, ok := dataTypeIds[t]; ok && existing != id {
+		panic(fmt.Sprintf("game data type %s registered with both id %d and %d", t, existing, id))
+	}
+
+	dataTypes[id] = t
+	dataTypeIds[t] = id
+}
+
+func getDataType(data interface{}) int32 {
+	return dataTypeIds[reflect.TypeOf(data)] // 0 is ignored by omitempty so it won't be put in the db
 }
 
 type IGame interface {
@@ -46,6 +62,12 @@ type Game struct {
 	StartTime *time.Time     `bson:"startTime,omitempty"`
 	Players   []*BasicPlayer `bson:"players"`
 
+	MapId *string `bson:"mapId,omitempty"`
+	// MatchId is the matchmaker match the game was allocated for
+	MatchId *string `bson:"matchId,omitempty"`
+	// Private is true for games of a private lobby rather than a public queue
+	Private bool `bson:"private,omitempty"`
+
 	// The below data is all optional and varies by game mode
 
 	TeamData *[]*Team `bson:"teams,omitempty"`
@@ -53,59 +75,99 @@ type Game struct {
 	// GameData is data specific to the game mode. It is only present if the game sends it
 	GameData     interface{} `bson:"gameData,omitempty"`
 	GameDataType int32       `bson:"gameDataType,omitempty"`
+
+	// RawContent is content the game sent that no parser handles
+	RawContent []*RawContent `bson:"rawContent,omitempty"`
 }
 
 func (g *Game) GetGame() *Game {
 	return g
 }
 
+// MergeMetadata fills in the map, match and private flag of g from other where g doesn't know them
+func (g *Game) MergeMetadata(other *Game) {
+	if g.MapId == nil {
+		g.MapId = other.MapId
+	}
+	if g.MatchId == nil {
+		g.MatchId = other.MatchId
+	}
+	g.Private = g.Private || other.Private
+}
+
 func (g *Game) SetGameData(data interface{}) {
 	g.GameData = data
 	g.GameDataType = getDataType(data)
 }
 
+// HistoricDataSource is implemented by live game data that should be kept when the game finishes,
+// for game modes whose finish message doesn't contain the final state
+type HistoricDataSource interface {
+	ToHistoricData() interface{}
+}
+
+// ProtoGameData is implemented by game data that can be returned over gRPC
+type ProtoGameData interface {
+	ToProto() proto.Message
+}
+
+// GameDataToAnyProto returns the game data as an Any, or nil if there is no game data that can be converted.
+func (g *Game) GameDataToAnyProto() (*anypb.Any, error) {
+	data, ok := g.GameData.(ProtoGameData)
+	if !ok {
+		return nil, nil
+	}
+
+	return anypb.New(data.ToProto())
+}
+
 // ParseGameData converts and replaces the game data from bson.D to the correct type
 func (g *Game) ParseGameData() error {
-	if g.GameData == nil {
-		return nil
+	data, err := decodeGameData(g.GameData, g.GameDataType)
+	if err != nil {
+		return err
 	}
 
-	if g.GameDataType == 0 {
-		return fmt.Errorf("game data type not set but game data is present")
+	g.GameData = data
+	return nil
+}
+
+func decodeGameData(gameData interface{}, gameDataType int32) (interface{}, error) {
+	if gameData == nil {
+		return nil, nil
 	}
 
-	example, ok := dataType[g.GameDataType]
-	if !ok {
-		return fmt.Errorf("unknown game data type: %d", g.GameDataType)
+	if gameDataType == 0 {
+		return nil, fmt.Errorf("game data type not set but game data is present")
 	}
 
-	if example == nil {
-		return fmt.Errorf("unknown game data type: %d", g.GameDataType)
+	dataType, ok := dataTypes[gameDataType]
+	if !ok {
+		return nil, fmt.Errorf("unknown game data type: %d", gameDataType)
 	}
 
 	// Convert the interface{} to bytes
-	bytes, err := bson.Marshal(g.GameData)
+	bytes, err := bson.Marshal(gameData)
 	if err != nil {
-		return fmt.Errorf("failed to marshal game data: %w", err)
+		return nil, fmt.Errorf("failed to marshal game data: %w", err)
 	}
 
 	// Parse the bytes from the interface{} into the correct type
 	dec, err := bson.NewDecoder(bsonrw.NewBSONDocumentReader(bytes))
 	if err != nil {
-		return fmt.Errorf("failed to create decoder: %w", err)
+		return nil, fmt.Errorf("failed to create decoder: %w", err)
 	}
 
 	if err := dec.SetRegistry(registrytypes.CodecRegistry); err != nil {
-		return fmt.Errorf("failed to set registry: %w", err)
+		return nil, fmt.Errorf("failed to set registry: %w", err)
 	}
 
-	if err := dec.Decode(example); err != nil {
-		return fmt.Errorf("failed to decode: %w", err)
+	data := reflect.New(dataType.Elem()).Interface()
+	if err := dec.Decode(data); err != nil {
+		return nil, fmt.Errorf("failed to decode: %w", err)
 	}
 
-	g.GameData = example
-
-	return nil
+	return data, nil
 }
 
 type LiveGame struct {
@@ -114,6 +176,56 @@ type LiveGame struct {
 
 	// GameData provided at game create (allocation messages)
 	LastUpdated time.Time `bson:"lastUpdated"`
+
+	// LastMessage is the position of the newest message applied to the game, used to ignore duplicate and stale messages
+	LastMessage *MessagePosition `bson:"lastMessage,omitempty"`
+
+	// TimelineEntries is how many entries were added to the game's timeline, used to space out keyframes
+	TimelineEntries int `bson:"timelineEntries,omitempty"`
+}
+
+// MessagePosition is where a message was read from in Kafka.
+// Games don't send sequence numbers, so the offset orders messages within a partition and the timestamp across partitions.
+type MessagePosition struct {
+	Time      time.Time `bson:"time"`
+	Partition int       `bson:"partition"`
+	Offset    int64     `bson:"offset"`
+}
+
+// IsStale returns true if the game has already applied the message at p, or a newer one
+func (g *LiveGame) IsStale(p *MessagePosition) bool {
+	last := g.LastMessage
+	if last == nil {
+		return false
+	}
+
+	if last.Partition == p.Partition {
+		return p.Offset <= last.Offset
+	}
+
+	return !p.Time.After(last.Time)
+}
+
+func (g *LiveGame) ToProto() (*gametrackerpb.LiveGame, error) {
+	gameData, err := g.GameDataToAnyProto()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert game data: %w", err)
+	}
+
+	return &gametrackerpb.LiveGame{
+		Id:          g.Id.Hex(),
+		GameModeId:  g.GameModeId,
+		ServerId:    g.ServerId,
+		StartTime:   timeToProto(g.StartTime),
+		LastUpdated: timestamppb.New(g.LastUpdated),
+		Players:     BasicPlayersToProto(g.Players),
+		Teams:       g.teamsToProto(),
+		GameData:    gameData,
+		RawContent:  g.rawContentToProto(),
+		MapId:       g.MapId,
+		MatchId:     g.MatchId,
+		Private:     g.Private,
+	}, nil
 }
 
 type HistoricGame struct {
@@ -122,8 +234,85 @@ type HistoricGame struct {
 
 	EndTime time.Time `bson:"endTime"`
 
+	// Outcome is empty for games stored before outcomes were tracked, which all finished normally
+	Outcome GameOutcome `bson:"outcome,omitempty"`
+
 	// The below data is all optional and varies by game mode
 	WinnerData *HistoricWinnerData `bson:"winnerData,omitempty"`
+
+	// SummarizedAt is set once retention removed the detailed data of the game, see Summarize
+	SummarizedAt *time.Time `bson:"summarizedAt,omitempty"`
+	// PlayerCounters are the stats counters of the removed game data, keyed by player id, so stats can still be rebuilt
+	PlayerCounters map[string]map[string]int64 `bson:"playerCounters,omitempty"`
+}
+
+// Summarize reduces the game to what stats and history need: players, winners, start and end time and map.
+// Game data and raw content are removed, the game data's stats counters are kept in PlayerCounters. Teams are kept
+// as map stats are rebuilt from them.
+func (g *HistoricGame) Summarize(now time.Time) {
+	if source, ok := g.GameData.(PlayerCounterSource); ok {
+		counters := source.PlayerCounters()
+
+		g.PlayerCounters = make(map[string]map[string]int64, len(counters))
+		for id, c := range counters {
+			g.PlayerCounters[id.String()] = c
+		}
+	}
+
+	g.GameData = nil
+	g.GameDataType = 0
+	g.RawContent = nil
+	g.SummarizedAt = &now
+}
+
+type GameOutcome string
+
+const (
+	GameOutcomeFinished  GameOutcome = "finished"
+	GameOutcomeAbandoned GameOutcome = "abandoned"
+)
+
+func (o GameOutcome) ToProto() gametrackerpb.GameOutcome {
+	if o == GameOutcomeAbandoned {
+		return gametrackerpb.GameOutcome_ABANDONED
+	}
+
+	return gametrackerpb.GameOutcome_FINISHED
+}
+
+// NewAbandonedGame creates the historic game of a live game that never finished.
+// The last update is used as the end time and the live game data is kept as the last known state.
+func NewAbandonedGame(g *LiveGame) *HistoricGame {
+	return &HistoricGame{
+		Game:    g.Game,
+		EndTime: g.LastUpdated,
+		Outcome: GameOutcomeAbandoned,
+	}
+}
+
+func (g *HistoricGame) ToProto() (*gametrackerpb.HistoricGame, error) {
+	gameData, err := g.GameDataToAnyProto()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert game data: %w", err)
+	}
+
+	return &gametrackerpb.HistoricGame{
+		Id:           g.Id.Hex(),
+		GameModeId:   g.GameModeId,
+		ServerId:     g.ServerId,
+		StartTime:    timeToProto(g.StartTime),
+		EndTime:      timestamppb.New(g.EndTime),
+		Players:      BasicPlayersToProto(g.Players),
+		Teams:        g.teamsToProto(),
+		WinnerData:   g.WinnerData.ToProto(),
+		GameData:     gameData,
+		Outcome:      g.Outcome.ToProto(),
+		RawContent:   g.rawContentToProto(),
+		SummarizedAt: timeToProto(g.SummarizedAt),
+		MapId:        g.MapId,
+		MatchId:      g.MatchId,
+		Private:      g.Private,
+	}, nil
 }
 
 type HistoricWinnerData struct {
@@ -148,6 +337,17 @@ func HistoricWinnerDataFromProto(d *gametracker.CommonGameFinishWinnerData) (*Hi
 	}, nil
 }
 
+func (d *HistoricWinnerData) ToProto() *gametracker.CommonGameFinishWinnerData {
+	if d == nil {
+		return nil
+	}
+
+	return &gametracker.CommonGameFinishWinnerData{
+		WinnerIds: UuidsToStrings(d.WinnerIds),
+		LoserIds:  UuidsToStrings(d.LoserIds),
+	}
+}
+
 type BasicPlayer struct {
 	Id       uuid.UUID `bson:"id"`
 	Username string    `bson:"username"`
@@ -179,6 +379,22 @@ func BasicPlayersFromProto(players []*gametracker.BasicGamePlayer) ([]*BasicPlay
 	return basicPlayers, nil
 }
 
+func (p *BasicPlayer) ToProto() *gametracker.BasicGamePlayer {
+	return &gametracker.BasicGamePlayer{
+		Id:       p.Id.String(),
+		Username: p.Username,
+	}
+}
+
+func BasicPlayersToProto(players []*BasicPlayer) []*gametracker.BasicGamePlayer {
+	protoPlayers := make([]*gametracker.BasicGamePlayer, len(players))
+	for i, p := range players {
+		protoPlayers[i] = p.ToProto()
+	}
+
+	return protoPlayers
+}
+
 type Team struct {
 	Id           string      `bson:"id"`
 	FriendlyName string      `bson:"friendlyName"`
@@ -205,6 +421,49 @@ func TeamFromProto(t *gametracker.Team) (*Team, error) {
 	}, nil
 }
 
+func (t *Team) ToProto() *gametracker.Team {
+	return &gametracker.Team{
+		Id:           t.Id,
+		FriendlyName: t.FriendlyName,
+		Color:        t.Color,
+		PlayerIds:    UuidsToStrings(t.PlayerIds),
+	}
+}
+
+func (g *Game) teamsToProto() []*gametracker.Team {
+	if g.TeamData == nil {
+		return nil
+	}
+
+	return teamsToProto(*g.TeamData)
+}
+
+func teamsToProto(teams []*Team) []*gametracker.Team {
+	protoTeams := make([]*gametracker.Team, len(teams))
+	for i, t := range teams {
+		protoTeams[i] = t.ToProto()
+	}
+
+	return protoTeams
+}
+
+func UuidsToStrings(ids []uuid.UUID) []string {
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+
+	return strs
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
 func ParseUuids(uuidStrs []string) ([]uuid.UUID, error) {
 	ids := make([]uuid.UUID, len(uuidStrs))
 	for i, id := range uuidStrs {
@@ -0,0 +1,68 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLiveGame_IsStale(t *testing.T) {
+	lastTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	last := &MessagePosition{Time: lastTime, Partition: 1, Offset: 100}
+
+	tests := []struct {
+		name string
+		last *MessagePosition
+		p    *MessagePosition
+		want bool
+	}{
+		{
+			name: "no message applied yet",
+			p:    &MessagePosition{Time: lastTime, Partition: 1, Offset: 0},
+			want: false,
+		},
+		{
+			name: "same message",
+			last: last,
+			p:    &MessagePosition{Time: lastTime, Partition: 1, Offset: 100},
+			want: true,
+		},
+		{
+			name: "older offset in the same partition",
+			last: last,
+			p:    &MessagePosition{Time: lastTime.Add(time.Minute), Partition: 1, Offset: 99},
+			want: true,
+		},
+		{
+			name: "newer offset in the same partition",
+			last: last,
+			p:    &MessagePosition{Time: lastTime.Add(-time.Minute), Partition: 1, Offset: 101},
+			want: false,
+		},
+		{
+			name: "older time in another partition",
+			last: last,
+			p:    &MessagePosition{Time: lastTime.Add(-time.Second), Partition: 2, Offset: 500},
+			want: true,
+		},
+		{
+			name: "same time in another partition",
+			last: last,
+			p:    &MessagePosition{Time: lastTime, Partition: 2, Offset: 500},
+			want: true,
+		},
+		{
+			name: "newer time in another partition",
+			last: last,
+			p:    &MessagePosition{Time: lastTime.Add(time.Second), Partition: 2, Offset: 0},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &LiveGame{LastMessage: tt.last}
+			assert.Equal(t, tt.want, g.IsStale(tt.p))
+		})
+	}
+}
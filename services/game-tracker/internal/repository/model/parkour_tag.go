@@ -0,0 +1,109 @@
+package model
+
+import (
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+const CounterTags = "tags"
+
+type ParkourTagPlayer struct {
+	Tags       int32      `bson:"tags"`
+	TaggedTime *time.Time `bson:"taggedTime,omitempty"`
+}
+
+func createParkourTagPlayers(players map[string]*gametrackerpb.ParkourTagPlayer) (map[uuid.UUID]*ParkourTagPlayer, error) {
+	parsed := make(map[uuid.UUID]*ParkourTagPlayer, len(players))
+	for id, p := range players {
+		parsedId, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse player id: %w", err)
+		}
+
+		player := &ParkourTagPlayer{Tags: p.Tags}
+		if p.TaggedTime != nil {
+			taggedTime := p.TaggedTime.AsTime()
+			player.TaggedTime = &taggedTime
+		}
+
+		parsed[parsedId] = player
+	}
+
+	return parsed, nil
+}
+
+func parkourTagPlayersToProto(players map[uuid.UUID]*ParkourTagPlayer) map[string]*gametrackerpb.ParkourTagPlayer {
+	protoPlayers := make(map[string]*gametrackerpb.ParkourTagPlayer, len(players))
+	for id, p := range players {
+		protoPlayers[id.String()] = &gametrackerpb.ParkourTagPlayer{
+			Tags:       p.Tags,
+			TaggedTime: timeToProto(p.TaggedTime),
+		}
+	}
+
+	return protoPlayers
+}
+
+type LiveParkourTagData struct {
+	TaggerIds []uuid.UUID                     `bson:"taggerIds"`
+	Players   map[uuid.UUID]*ParkourTagPlayer `bson:"players"`
+}
+
+func CreateLiveParkourTagDataFromUpdate(data *gametrackerpb.ParkourTagUpdateData) (*LiveParkourTagData, error) {
+	taggerIds, err := ParseUuids(data.TaggerIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tagger ids: %w", err)
+	}
+
+	players, err := createParkourTagPlayers(data.Players)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LiveParkourTagData{TaggerIds: taggerIds, Players: players}, nil
+}
+
+func (d *LiveParkourTagData) ToProto() proto.Message {
+	return &gametrackerpb.ParkourTagUpdateData{
+		TaggerIds: UuidsToStrings(d.TaggerIds),
+		Players:   parkourTagPlayersToProto(d.Players),
+	}
+}
+
+type HistoricParkourTagData struct {
+	TaggerIds []uuid.UUID                     `bson:"taggerIds"`
+	Players   map[uuid.UUID]*ParkourTagPlayer `bson:"players"`
+}
+
+func CreateHistoricParkourTagDataFromFinish(data *gametrackerpb.ParkourTagFinishData) (*HistoricParkourTagData, error) {
+	taggerIds, err := ParseUuids(data.TaggerIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tagger ids: %w", err)
+	}
+
+	players, err := createParkourTagPlayers(data.Players)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HistoricParkourTagData{TaggerIds: taggerIds, Players: players}, nil
+}
+
+func (d *HistoricParkourTagData) ToProto() proto.Message {
+	return &gametrackerpb.ParkourTagFinishData{
+		TaggerIds: UuidsToStrings(d.TaggerIds),
+		Players:   parkourTagPlayersToProto(d.Players),
+	}
+}
+
+func (d *HistoricParkourTagData) PlayerCounters() map[uuid.UUID]map[string]int64 {
+	counters := make(map[uuid.UUID]map[string]int64, len(d.Players))
+	for id, p := range d.Players {
+		counters[id] = map[string]int64{CounterTags: int64(p.Tags)}
+	}
+
+	return counters
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+const (
+	CounterKills      = "kills"
+	CounterFinalKills = "finalKills"
+)
+
+// PlayerStats are the totals of a player for a single game mode, aggregated from historic games.
+type PlayerStats struct {
+	PlayerId   uuid.UUID `bson:"playerId"`
+	GameModeId string    `bson:"gameModeId"`
+
+	GamesPlayed int64         `bson:"gamesPlayed"`
+	Wins        int64         `bson:"wins"`
+	Losses      int64         `bson:"losses"`
+	Playtime    time.Duration `bson:"playtime"`
+
+	// Counters are game mode specific totals, e.g. kills in Block Sumo
+	Counters map[string]int64 `bson:"counters,omitempty"`
+}
+
+// Add adds the totals of other to s. Both must be for the same player and game mode.
+func (s *PlayerStats) Add(other *PlayerStats) {
+	s.GamesPlayed += other.GamesPlayed
+	s.Wins += other.Wins
+	s.Losses += other.Losses
+	s.Playtime += other.Playtime
+
+	for k, v := range other.Counters {
+		if s.Counters == nil {
+			s.Counters = make(map[string]int64)
+		}
+		s.Counters[k] += v
+	}
+}
+
+func (s *PlayerStats) ToProto() *gametrackerpb.PlayerGameModeStats {
+	return &gametrackerpb.PlayerGameModeStats{
+		GameModeId:  s.GameModeId,
+		GamesPlayed: s.GamesPlayed,
+		Wins:        s.Wins,
+		Losses:      s.Losses,
+		Playtime:    durationpb.New(s.Playtime),
+		Counters:    s.Counters,
+	}
+}
+
+// PlayerCounterSource is implemented by historic game data that contributes game mode specific counters to PlayerStats
+type PlayerCounterSource interface {
+	PlayerCounters() map[uuid.UUID]map[string]int64
+}
+
+// PlayerStatsFromGame creates the stats each player of the game gained from it.
+// Abandoned games didn't finish so they don't count towards any stats.
+func PlayerStatsFromGame(g *HistoricGame) []*PlayerStats {
+	if g.Outcome == GameOutcomeAbandoned {
+		return nil
+	}
+
+	var playtime time.Duration
+	if g.StartTime != nil && g.EndTime.After(*g.StartTime) {
+		playtime = g.EndTime.Sub(*g.StartTime)
+	}
+
+	winners := make(map[uuid.UUID]bool)
+	losers := make(map[uuid.UUID]bool)
+	if g.WinnerData != nil {
+		for _, id := range g.WinnerData.WinnerIds {
+			winners[id] = true
+		}
+		for _, id := range g.WinnerData.LoserIds {
+			losers[id] = true
+		}
+	}
+
+	var counters map[uuid.UUID]map[string]int64
+	if source, ok := g.GameData.(PlayerCounterSource); ok {
+		counters = source.PlayerCounters()
+	} else if g.PlayerCounters != nil {
+		counters = make(map[uuid.UUID]map[string]int64, len(g.PlayerCounters))
+		for idStr, c := range g.PlayerCounters {
+			id, err := uuid.Parse(idStr)
+			if err != nil {
+				continue // Only ever written from uuids by Summarize
+			}
+			counters[id] = c
+		}
+	}
+
+	stats := make([]*PlayerStats, 0, len(g.Players))
+	seen := make(map[uuid.UUID]bool, len(g.Players))
+	for _, p := range g.Players {
+		if seen[p.Id] {
+			continue
+		}
+		seen[p.Id] = true
+
+		s := &PlayerStats{
+			PlayerId:    p.Id,
+			GameModeId:  g.GameModeId,
+			GamesPlayed: 1,
+			Playtime:    playtime,
+			Counters:    counters[p.Id],
+		}
+
+		if winners[p.Id] {
+			s.Wins = 1
+		} else if losers[p.Id] {
+			s.Losses = 1
+		}
+
+		stats = append(stats, s)
+	}
+
+	return stats
+}
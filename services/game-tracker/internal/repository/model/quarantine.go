@@ -0,0 +1,77 @@
+package model
+
+import (
+	"github.com/segmentio/kafka-go"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// QuarantinedMessage is a game message that couldn't be applied, kept so it can be replayed once the cause is fixed.
+type QuarantinedMessage struct {
+	Id primitive.ObjectID `bson:"_id"`
+
+	Topic     string `bson:"topic"`
+	Partition int    `bson:"partition"`
+	Offset    int64  `bson:"offset"`
+	// Time is when the message was originally produced
+	Time time.Time `bson:"time"`
+
+	Key     []byte             `bson:"key,omitempty"`
+	Headers []QuarantineHeader `bson:"headers,omitempty"`
+	// Value is the raw message, so it can be replayed even if it no longer parses
+	Value []byte `bson:"value"`
+
+	// ProtoType is the X-Proto-Type header, e.g. "emortal.message.gametracker.GameUpdateMessage"
+	ProtoType string `bson:"protoType"`
+	Error     string `bson:"error"`
+
+	QuarantinedAt time.Time  `bson:"quarantinedAt"`
+	ReplayedAt    *time.Time `bson:"replayedAt,omitempty"`
+}
+
+type QuarantineHeader struct {
+	Key   string `bson:"key"`
+	Value []byte `bson:"value"`
+}
+
+func NewQuarantinedMessage(m *kafka.Message, handleErr error) *QuarantinedMessage {
+	q := &QuarantinedMessage{
+		Id:            primitive.NewObjectID(),
+		Topic:         m.Topic,
+		Partition:     m.Partition,
+		Offset:        m.Offset,
+		Time:          m.Time,
+		Key:           m.Key,
+		Value:         m.Value,
+		Error:         handleErr.Error(),
+		QuarantinedAt: time.Now(),
+	}
+
+	for _, h := range m.Headers {
+		q.Headers = append(q.Headers, QuarantineHeader{Key: h.Key, Value: h.Value})
+		if h.Key == "X-Proto-Type" {
+			q.ProtoType = string(h.Value)
+		}
+	}
+
+	return q
+}
+
+// KafkaMessage returns the message as it was originally read, keeping its original position so replaying it is
+// ordered against the other messages of its game (see LiveGame.IsStale).
+func (q *QuarantinedMessage) KafkaMessage() kafka.Message {
+	headers := make([]kafka.Header, len(q.Headers))
+	for i, h := range q.Headers {
+		headers[i] = kafka.Header{Key: h.Key, Value: h.Value}
+	}
+
+	return kafka.Message{
+		Topic:     q.Topic,
+		Partition: q.Partition,
+		Offset:    q.Offset,
+		Key:       q.Key,
+		Value:     q.Value,
+		Headers:   headers,
+		Time:      q.Time,
+	}
+}
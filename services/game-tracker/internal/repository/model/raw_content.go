@@ -0,0 +1,69 @@
+package model
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// RawContent is game content that has no registered parser. It is stored as is so it isn't lost.
+type RawContent struct {
+	// Type is the full name of the content message
+	Type string `bson:"type"`
+	Data []byte `bson:"data"`
+
+	// Document is the content as a document so it can be queried. It is only set if the message type is known.
+	Document bson.M `bson:"document,omitempty"`
+}
+
+func RawContentFromAny(a *anypb.Any) *RawContent {
+	content := &RawContent{
+		Type: string(a.MessageName()),
+		Data: a.Value,
+	}
+
+	msg, err := a.UnmarshalNew()
+	if err != nil {
+		return content // The type isn't in the proto registry, only the bytes can be kept
+	}
+
+	json, err := protojson.Marshal(msg)
+	if err != nil {
+		return content
+	}
+
+	var doc bson.M
+	if err := bson.UnmarshalExtJSON(json, false, &doc); err == nil {
+		content.Document = doc
+	}
+
+	return content
+}
+
+func (c *RawContent) ToProto() *anypb.Any {
+	return &anypb.Any{
+		TypeUrl: "type.googleapis.com/" + c.Type,
+		Value:   c.Data,
+	}
+}
+
+// SetRawContent stores the content, replacing any previous content of the same type so live games keep the newest
+func (g *Game) SetRawContent(content *RawContent) {
+	for i, existing := range g.RawContent {
+		if existing.Type == content.Type {
+			g.RawContent[i] = content
+			return
+		}
+	}
+
+	g.RawContent = append(g.RawContent, content)
+}
+
+func (g *Game) rawContentToProto() []*anypb.Any {
+	protoContent := make([]*anypb.Any, len(g.RawContent))
+	for i, c := range g.RawContent {
+		protoContent[i] = c.ToProto()
+	}
+
+	return protoContent
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// Review is a game or player flagged by an anomaly check for staff to look at.
+// Game reviews have a GameId, player reviews of a game also have a PlayerId, and player reviews of rolling stats
+// only have a PlayerId.
+type Review struct {
+	Id         primitive.ObjectID `bson:"_id"`
+	GameModeId string             `bson:"gameModeId"`
+
+	GameId   *primitive.ObjectID `bson:"gameId,omitempty"`
+	PlayerId *uuid.UUID          `bson:"playerId,omitempty"`
+
+	// Check is the name of the check that failed, e.g. "maxCounter:kills"
+	Check string `bson:"check"`
+	// Value broke the Threshold of the check
+	Value     float64 `bson:"value"`
+	Threshold float64 `bson:"threshold"`
+
+	CreatedAt time.Time `bson:"createdAt"`
+
+	ResolvedAt *time.Time `bson:"resolvedAt,omitempty"`
+	ResolvedBy string     `bson:"resolvedBy,omitempty"`
+
+	// OpenKey is only set on unresolved player reviews of rolling stats, so a player has at most one per check
+	OpenKey string `bson:"openKey,omitempty"`
+}
+
+// NewReview creates an unresolved review
+func NewReview(gameModeId string, gameId *primitive.ObjectID, playerId *uuid.UUID, check string, value float64,
+	threshold float64) *Review {
+
+	r := &Review{
+		Id:         primitive.NewObjectID(),
+		GameModeId: gameModeId,
+		GameId:     gameId,
+		PlayerId:   playerId,
+		Check:      check,
+		Value:      value,
+		Threshold:  threshold,
+		CreatedAt:  time.Now(),
+	}
+
+	if gameId == nil && playerId != nil {
+		r.OpenKey = playerId.String() + ":" + gameModeId + ":" + check
+	}
+
+	return r
+}
+
+func (r *Review) ToProto() *gametrackerpb.Review {
+	pb := &gametrackerpb.Review{
+		Id:         r.Id.Hex(),
+		GameModeId: r.GameModeId,
+		Check:      r.Check,
+		Value:      r.Value,
+		Threshold:  r.Threshold,
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+		ResolvedAt: timeToProto(r.ResolvedAt),
+	}
+
+	if r.GameId != nil {
+		gameId := r.GameId.Hex()
+		pb.GameId = &gameId
+	}
+	if r.PlayerId != nil {
+		playerId := r.PlayerId.String()
+		pb.PlayerId = &playerId
+	}
+	if r.ResolvedBy != "" {
+		pb.ResolvedBy = &r.ResolvedBy
+	}
+
+	return pb
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// RewardPayout is the XP given to the players of a game. There is at most one per game.
+type RewardPayout struct {
+	GameId     primitive.ObjectID `bson:"_id"`
+	GameModeId string             `bson:"gameModeId"`
+
+	Rewards   []*PlayerReward `bson:"rewards"`
+	CreatedAt time.Time       `bson:"createdAt"`
+
+	// Pending is set until every reward is paid, so a payout interrupted before it finished is still retried
+	Pending bool `bson:"pending,omitempty"`
+	// Error is set if paying out failed, in which case some players may not have been paid
+	Error string `bson:"error,omitempty"`
+	// Attempts is how many times paying out failed
+	Attempts int `bson:"attempts,omitempty"`
+}
+
+type PlayerReward struct {
+	PlayerId   uuid.UUID `bson:"playerId"`
+	Experience uint64    `bson:"experience"`
+	// Paid is set once the XP was given, so retries skip it
+	Paid bool `bson:"paid,omitempty"`
+	// SendingId is set while the XP is being given. A reward that keeps it without being paid may or may not have
+	// been given, so it is never sent again.
+	SendingId string `bson:"sendingId,omitempty"`
+}
+
+// Reason is the reason given to the mc-player-service, tying the XP to the game
+func (p *RewardPayout) Reason() string {
+	return fmt.Sprintf("game:%s:%s", p.GameModeId, p.GameId.Hex())
+}
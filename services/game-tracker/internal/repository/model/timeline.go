@@ -0,0 +1,185 @@
+package model
+
+import (
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"reflect"
+	"time"
+)
+
+// GameTimeline is the history of a game's state. It has the same id as the game and outlives the live game.
+type GameTimeline struct {
+	GameId  primitive.ObjectID `bson:"_id"`
+	Entries []*TimelineEntry   `bson:"entries"`
+}
+
+// TimelineKeyframeInterval is how often an entry stores the full game data instead of only the changed fields,
+// so the game data can still be rebuilt once the oldest entries of a long game are dropped.
+const TimelineKeyframeInterval = 20
+
+// TimelineEntry only contains what changed since the previous entry, unset fields are unchanged
+type TimelineEntry struct {
+	Time time.Time `bson:"time"`
+
+	TeamData *[]*Team `bson:"teams,omitempty"`
+
+	// GameData is the full game data, only stored in keyframes. ParseGameData fills it in for the other entries.
+	GameData     interface{} `bson:"gameData,omitempty"`
+	GameDataType int32       `bson:"gameDataType,omitempty"`
+	// GameDataChanges are the top level fields of the game data that changed since the previous entry.
+	// Removed fields are nil.
+	GameDataChanges bson.M `bson:"gameDataChanges,omitempty"`
+}
+
+// TimelineState is the state of a game to compare against after it is updated.
+// Game data is updated in place, so it is captured as a document to compare.
+type TimelineState struct {
+	teamData     *[]*Team
+	gameData     bson.M
+	gameDataType int32
+}
+
+func CaptureTimelineState(g *Game) TimelineState {
+	state := TimelineState{teamData: g.TeamData, gameDataType: g.GameDataType}
+	if g.GameData != nil {
+		// A game data that can't be captured is stored in full by the next entry
+		state.gameData, _ = toDocument(g.GameData)
+	}
+
+	return state
+}
+
+// NewTimelineEntry returns what changed in the game since the state was captured, or nil if nothing changed.
+// Keyframes store the full game data if anything changed.
+func NewTimelineEntry(before TimelineState, g *Game, t time.Time, keyframe bool) *TimelineEntry {
+	entry := &TimelineEntry{Time: t}
+	changed := false
+
+	if g.TeamData != nil && !reflect.DeepEqual(before.teamData, g.TeamData) {
+		entry.TeamData = g.TeamData
+		changed = true
+	}
+
+	if g.GameData != nil {
+		after, err := toDocument(g.GameData)
+		if err != nil || before.gameData == nil || before.gameDataType != g.GameDataType {
+			entry.GameData = g.GameData
+			entry.GameDataType = g.GameDataType
+			changed = true
+		} else if changes := documentChanges(before.gameData, after); len(changes) > 0 {
+			entry.GameDataChanges = changes
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if keyframe && g.GameData != nil {
+		entry.GameData = g.GameData
+		entry.GameDataType = g.GameDataType
+		entry.GameDataChanges = nil
+	}
+
+	return entry
+}
+
+// ParseGameData rebuilds the full game data of every entry that changed it, starting from the oldest keyframe.
+// Entries before the oldest keyframe are left without game data as their earlier changes were dropped.
+func (t *GameTimeline) ParseGameData() error {
+	var state bson.M
+	var stateType int32
+
+	for _, e := range t.Entries {
+		switch {
+		case e.GameData != nil:
+			doc, err := toDocument(e.GameData)
+			if err != nil {
+				return fmt.Errorf("failed to read game data of entry at %s: %w", e.Time, err)
+			}
+			state, stateType = doc, e.GameDataType
+		case e.GameDataChanges != nil && state != nil:
+			for key, value := range e.GameDataChanges {
+				if value == nil {
+					delete(state, key)
+				} else {
+					state[key] = value
+				}
+			}
+		default:
+			continue
+		}
+
+		data, err := decodeGameData(state, stateType)
+		if err != nil {
+			return fmt.Errorf("failed to parse game data of entry at %s: %w", e.Time, err)
+		}
+
+		e.GameData = data
+		e.GameDataType = stateType
+		e.GameDataChanges = nil
+	}
+
+	return nil
+}
+
+func toDocument(v interface{}) (bson.M, error) {
+	bytes, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc bson.M
+	if err := bson.Unmarshal(bytes, &doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// documentChanges returns the top level fields that differ between the documents, with nil for removed fields
+func documentChanges(before bson.M, after bson.M) bson.M {
+	changes := bson.M{}
+	for key, value := range after {
+		if beforeValue, ok := before[key]; !ok || !reflect.DeepEqual(beforeValue, value) {
+			changes[key] = value
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changes[key] = nil
+		}
+	}
+
+	return changes
+}
+
+func (t *GameTimeline) ToProto() (*gametrackerpb.GameTimeline, error) {
+	entries := make([]*gametrackerpb.TimelineEntry, len(t.Entries))
+	for i, e := range t.Entries {
+		entry := &gametrackerpb.TimelineEntry{Time: timestamppb.New(e.Time)}
+
+		if e.TeamData != nil {
+			entry.Teams = teamsToProto(*e.TeamData)
+			entry.TeamsChanged = true
+		}
+
+		if data, ok := e.GameData.(ProtoGameData); ok {
+			gameData, err := anypb.New(data.ToProto())
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert game data: %w", err)
+			}
+
+			entry.GameData = gameData
+		}
+
+		entries[i] = entry
+	}
+
+	return &gametrackerpb.GameTimeline{GameId: t.GameId.Hex(), Entries: entries}, nil
+}
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"testing"
+	"time"
+)
+
+type timelineTestData struct {
+	Score int32  `bson:"score"`
+	Phase string `bson:"phase"`
+	Bonus *int32 `bson:"bonus,omitempty"`
+}
+
+type otherTimelineTestData struct {
+	Round int32 `bson:"round"`
+}
+
+func init() {
+	RegisterDataType(1_000_001, &timelineTestData{})
+	RegisterDataType(1_000_002, &otherTimelineTestData{})
+}
+
+func TestNewTimelineEntry(t *testing.T) {
+	bonus := int32(3)
+	teams := &[]*Team{{Id: "red"}}
+	entryTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   *Game
+		after    *Game
+		keyframe bool
+
+		want *TimelineEntry
+	}{
+		{
+			name:   "nothing changed",
+			before: gameWithData(&timelineTestData{Score: 1}),
+			after:  gameWithData(&timelineTestData{Score: 1}),
+		},
+		{
+			name:   "first game data",
+			before: &Game{},
+			after:  gameWithData(&timelineTestData{Score: 1}),
+			want:   &TimelineEntry{Time: entryTime, GameData: &timelineTestData{Score: 1}, GameDataType: 1_000_001},
+		},
+		{
+			name:   "changed field",
+			before: gameWithData(&timelineTestData{Score: 1, Phase: "start"}),
+			after:  gameWithData(&timelineTestData{Score: 2, Phase: "start"}),
+			want:   &TimelineEntry{Time: entryTime, GameDataChanges: bson.M{"score": int32(2)}},
+		},
+		{
+			name:   "removed field",
+			before: gameWithData(&timelineTestData{Score: 1, Bonus: &bonus}),
+			after:  gameWithData(&timelineTestData{Score: 1}),
+			want:   &TimelineEntry{Time: entryTime, GameDataChanges: bson.M{"bonus": nil}},
+		},
+		{
+			name:   "changed game data type",
+			before: gameWithData(&timelineTestData{Score: 1}),
+			after:  gameWithData(&otherTimelineTestData{Round: 1}),
+			want:   &TimelineEntry{Time: entryTime, GameData: &otherTimelineTestData{Round: 1}, GameDataType: 1_000_002},
+		},
+		{
+			name:     "keyframe stores full game data",
+			before:   gameWithData(&timelineTestData{Score: 1, Phase: "start"}),
+			after:    gameWithData(&timelineTestData{Score: 2, Phase: "start"}),
+			keyframe: true,
+			want:     &TimelineEntry{Time: entryTime, GameData: &timelineTestData{Score: 2, Phase: "start"}, GameDataType: 1_000_001},
+		},
+		{
+			name:     "keyframe without changes",
+			before:   gameWithData(&timelineTestData{Score: 1}),
+			after:    gameWithData(&timelineTestData{Score: 1}),
+			keyframe: true,
+		},
+		{
+			name:   "changed teams",
+			before: &Game{},
+			after:  &Game{TeamData: teams},
+			want:   &TimelineEntry{Time: entryTime, TeamData: teams},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := CaptureTimelineState(tt.before)
+			assert.Equal(t, tt.want, NewTimelineEntry(state, tt.after, entryTime, tt.keyframe))
+		})
+	}
+}
+
+func TestGameTimeline_ParseGameData(t *testing.T) {
+	bonus := int32(3)
+	states := []*timelineTestData{
+		{Score: 0, Phase: "start"},
+		{Score: 1, Phase: "start"},
+		{Score: 1, Phase: "start", Bonus: &bonus},
+		{Score: 2, Phase: "end", Bonus: &bonus},
+		{Score: 2, Phase: "end"},
+	}
+
+	// Entries are recorded the same way the consumer does, with a keyframe every third entry
+	g := &Game{}
+	timeline := &GameTimeline{}
+	for i, data := range states {
+		before := CaptureTimelineState(g)
+		g.SetGameData(data)
+
+		entry := NewTimelineEntry(before, g, time.Unix(int64(i), 0), i%3 == 0)
+		assert.NotNil(t, entry)
+		timeline.Entries = append(timeline.Entries, entry)
+	}
+
+	assert.Nil(t, timeline.Entries[1].GameData)
+	assert.NotNil(t, timeline.Entries[1].GameDataChanges)
+
+	assert.NoError(t, timeline.ParseGameData())
+	for i, e := range timeline.Entries {
+		assert.Equal(t, states[i], e.GameData, "entry %d", i)
+		assert.Nil(t, e.GameDataChanges, "entry %d", i)
+	}
+}
+
+func TestGameTimeline_ParseGameData_BeforeKeyframe(t *testing.T) {
+	// The entries before the oldest keyframe were dropped, so the first entry's changes can't be applied
+	timeline := &GameTimeline{Entries: []*TimelineEntry{
+		{GameDataChanges: bson.M{"score": int32(1)}},
+		{GameData: bson.M{"score": int32(2), "phase": "start"}, GameDataType: 1_000_001},
+		{GameDataChanges: bson.M{"score": int32(3)}},
+	}}
+
+	assert.NoError(t, timeline.ParseGameData())
+	assert.Nil(t, timeline.Entries[0].GameData)
+	assert.Equal(t, &timelineTestData{Score: 2, Phase: "start"}, timeline.Entries[1].GameData)
+	assert.Equal(t, &timelineTestData{Score: 3, Phase: "start"}, timeline.Entries[2].GameData)
+}
+
+func gameWithData(data interface{}) *Game {
+	g := &Game{}
+	g.SetGameData(data)
+	return g
+}
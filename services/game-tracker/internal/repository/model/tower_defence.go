@@ -1,6 +1,10 @@
 package model
 
-import "github.com/emortalmc/proto-specs/gen/go/model/gametracker"
+import (
+	"github.com/emortalmc/proto-specs/gen/go/model/gametracker"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
 
 type LiveTowerDefenceData struct {
 	MaxHealth  int32 `bson:"maxHealth"`
@@ -8,6 +12,14 @@ type LiveTowerDefenceData struct {
 	BlueHealth int32 `bson:"blueHealth"`
 }
 
+func (d *LiveTowerDefenceData) ToProto() proto.Message {
+	return &gametracker.TowerDefenceUpdateData{HealthData: &gametracker.TowerDefenceHealthData{
+		MaxHealth:  d.MaxHealth,
+		BlueHealth: d.BlueHealth,
+		RedHealth:  d.RedHealth,
+	}}
+}
+
 func (d *LiveTowerDefenceData) Update(data *gametracker.TowerDefenceUpdateData) {
 	healthData := data.HealthData
 
@@ -15,6 +27,16 @@ func (d *LiveTowerDefenceData) Update(data *gametracker.TowerDefenceUpdateData)
 	d.BlueHealth = healthData.BlueHealth
 }
 
+func CreateLiveTowerDefenceDataFromUpdate(data *gametracker.TowerDefenceUpdateData) *LiveTowerDefenceData {
+	healthData := data.HealthData
+
+	return &LiveTowerDefenceData{
+		MaxHealth:  healthData.MaxHealth,
+		RedHealth:  healthData.RedHealth,
+		BlueHealth: healthData.BlueHealth,
+	}
+}
+
 func CreateLiveTowerDefenceDataFromStart(data *gametracker.TowerDefenceStartData) *LiveTowerDefenceData {
 	healthData := data.HealthData
 
@@ -40,3 +62,38 @@ func CreateHistoricTowerDefenceDataFromFinish(data *gametracker.TowerDefenceFini
 		BlueHealth: healthData.BlueHealth,
 	}
 }
+
+func (d *HistoricTowerDefenceData) ToProto() proto.Message {
+	return &gametracker.TowerDefenceFinishData{HealthData: &gametracker.TowerDefenceHealthData{
+		MaxHealth:  d.MaxHealth,
+		BlueHealth: d.BlueHealth,
+		RedHealth:  d.RedHealth,
+	}}
+}
+
+// AchievementValues gives every player the health their team lost, for achievements such as winning without
+// losing health. The game chooses the team ids, so the red and blue teams are told apart by their colour.
+func (d *HistoricTowerDefenceData) AchievementValues(teams []*Team) map[uuid.UUID]map[string]int64 {
+	values := make(map[uuid.UUID]map[string]int64)
+
+	for _, t := range teams {
+		red := (t.Color >> 16) & 0xFF
+		blue := t.Color & 0xFF
+
+		var health int32
+		switch {
+		case red > blue:
+			health = d.RedHealth
+		case blue > red:
+			health = d.BlueHealth
+		default:
+			continue
+		}
+
+		for _, id := range t.PlayerIds {
+			values[id] = map[string]int64{"healthLost": int64(d.MaxHealth - health)}
+		}
+	}
+
+	return values
+}
@@ -6,10 +6,12 @@ import (
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/registrytypes"
+	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
 	"go.uber.org/zap"
 	"sync"
 	"time"
@@ -20,6 +22,22 @@ const (
 
 	liveGameCollectionName     = "liveGame"
 	historicGameCollectionName = "historicGame"
+	playerStatsCollectionName  = "playerStats"
+	leaderboardCollectionName  = "leaderboardEntry"
+	timelineCollectionName     = "gameTimeline"
+	rewardPayoutCollectionName = "rewardPayout"
+	prunedStatsCollectionName  = "prunedPlayerStats"
+	prunedMapStatsCollection   = "prunedMapStats"
+	mapStatsCollectionName     = "mapStats"
+	achievementCollectionName  = "achievementUnlock"
+	reviewCollectionName       = "review"
+	quarantineCollectionName   = "quarantinedMessage"
+	leaseCollectionName        = "lease"
+
+	playerStatsWriteBatchSize = 1000
+
+	// rebuildCollectionSuffix is appended to the name of a collection while its replacement is being written
+	rebuildCollectionSuffix = "_rebuild"
 )
 
 type mongoRepository struct {
@@ -27,6 +45,17 @@ type mongoRepository struct {
 
 	liveGameCollection     *mongo.Collection
 	historicGameCollection *mongo.Collection
+	playerStatsCollection  *mongo.Collection
+	leaderboardCollection  *mongo.Collection
+	timelineCollection     *mongo.Collection
+	rewardPayoutCollection *mongo.Collection
+	prunedStatsCollection  *mongo.Collection
+	prunedMapStatsColl     *mongo.Collection
+	mapStatsCollection     *mongo.Collection
+	achievementCollection  *mongo.Collection
+	reviewCollection       *mongo.Collection
+	quarantineCollection   *mongo.Collection
+	leaseCollection        *mongo.Collection
 }
 
 func NewMongoRepository(ctx context.Context, logger *zap.SugaredLogger, wg *sync.WaitGroup, cfg config.MongoDBConfig) (Repository, error) {
@@ -40,6 +69,17 @@ func NewMongoRepository(ctx context.Context, logger *zap.SugaredLogger, wg *sync
 		database:               database,
 		liveGameCollection:     database.Collection(liveGameCollectionName),
 		historicGameCollection: database.Collection(historicGameCollectionName),
+		playerStatsCollection:  database.Collection(playerStatsCollectionName),
+		leaderboardCollection:  database.Collection(leaderboardCollectionName),
+		timelineCollection:     database.Collection(timelineCollectionName),
+		rewardPayoutCollection: database.Collection(rewardPayoutCollectionName),
+		prunedStatsCollection:  database.Collection(prunedStatsCollectionName),
+		prunedMapStatsColl:     database.Collection(prunedMapStatsCollection),
+		mapStatsCollection:     database.Collection(mapStatsCollectionName),
+		achievementCollection:  database.Collection(achievementCollectionName),
+		reviewCollection:       database.Collection(reviewCollectionName),
+		quarantineCollection:   database.Collection(quarantineCollectionName),
+		leaseCollection:        database.Collection(leaseCollectionName),
 	}
 
 	wg.Add(1)
@@ -69,6 +109,14 @@ var (
 			Keys:    bson.D{{Key: "serverId", Value: 1}},
 			Options: options.Index().SetName("serverId"),
 		},
+		{
+			Keys:    bson.D{{Key: "mapId", Value: 1}},
+			Options: options.Index().SetName("mapId"),
+		},
+		{
+			Keys:    bson.D{{Key: "matchId", Value: 1}},
+			Options: options.Index().SetName("matchId"),
+		},
 	}
 	historicGameIndexes = []mongo.IndexModel{
 		{
@@ -91,15 +139,132 @@ var (
 			Keys:    bson.D{{Key: "endTime", Value: 1}},
 			Options: options.Index().SetName("endTime"),
 		},
+		{
+			// Used to page through a player's history, newest first
+			Keys:    bson.D{{Key: "players.id", Value: 1}, {Key: "endTime", Value: -1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("players.id_endTime"),
+		},
+		{
+			Keys:    bson.D{{Key: "gameModeId", Value: 1}, {Key: "endTime", Value: -1}},
+			Options: options.Index().SetName("gameModeId_endTime"),
+		},
+		{
+			Keys:    bson.D{{Key: "gameModeId", Value: 1}, {Key: "mapId", Value: 1}, {Key: "endTime", Value: -1}},
+			Options: options.Index().SetName("gameModeId_mapId_endTime"),
+		},
+		{
+			Keys:    bson.D{{Key: "matchId", Value: 1}},
+			Options: options.Index().SetName("matchId"),
+		},
 
 		// todo we might want indexes for game data and winner data
 	}
+	playerStatsIndexes = []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "playerId", Value: 1}, {Key: "gameModeId", Value: 1}},
+			Options: options.Index().SetName("playerId_gameModeId").SetUnique(true),
+		},
+	}
+	mapStatsIndexes = []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "gameModeId", Value: 1}, {Key: "mapId", Value: 1}},
+			Options: options.Index().SetName("gameModeId_mapId").SetUnique(true),
+		},
+	}
+	achievementIndexes = []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "playerId", Value: 1}, {Key: "achievementId", Value: 1}},
+			Options: options.Index().SetName("playerId_achievementId").SetUnique(true),
+		},
+		{
+			// Used to find failed unlocks to retry
+			Keys: bson.D{{Key: "attempts", Value: 1}},
+			Options: options.Index().SetName("attempts_failed").
+				SetPartialFilterExpression(bson.M{"error": bson.M{"$exists": true}}),
+		},
+	}
+	rewardPayoutIndexes = []mongo.IndexModel{
+		{
+			// Used to find pending payouts to retry
+			Keys: bson.D{{Key: "createdAt", Value: 1}},
+			Options: options.Index().SetName("createdAt_pending").
+				SetPartialFilterExpression(bson.M{"pending": true}),
+		},
+	}
+	reviewIndexes = []mongo.IndexModel{
+		{
+			// A game is only flagged once per check and player, even if its finish is processed again
+			Keys: bson.D{{Key: "gameId", Value: 1}, {Key: "playerId", Value: 1}, {Key: "check", Value: 1}},
+			Options: options.Index().SetName("gameId_playerId_check").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"gameId": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{{Key: "openKey", Value: 1}},
+			Options: options.Index().SetName("openKey").SetUnique(true).
+				SetPartialFilterExpression(bson.M{"openKey": bson.M{"$exists": true}}),
+		},
+		{
+			Keys:    bson.D{{Key: "playerId", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("playerId_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "gameModeId", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("gameModeId_id"),
+		},
+	}
+	quarantineIndexes = []mongo.IndexModel{
+		{
+			// A redelivered message is only quarantined once
+			Keys:    bson.D{{Key: "topic", Value: 1}, {Key: "partition", Value: 1}, {Key: "offset", Value: 1}},
+			Options: options.Index().SetName("topic_partition_offset").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "replayedAt", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("replayedAt_id"),
+		},
+	}
+	leaderboardIndexes = []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "gameModeId", Value: 1}, {Key: "window", Value: 1}, {Key: "periodStart", Value: 1},
+				{Key: "playerId", Value: 1}},
+			Options: options.Index().SetName("gameModeId_window_periodStart_playerId").SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "gameModeId", Value: 1}, {Key: "window", Value: 1}, {Key: "periodStart", Value: 1},
+				{Key: string(model.LeaderboardMetricWins), Value: -1}},
+			Options: options.Index().SetName("gameModeId_window_periodStart_wins"),
+		},
+		{
+			Keys: bson.D{{Key: "gameModeId", Value: 1}, {Key: "window", Value: 1}, {Key: "periodStart", Value: 1},
+				{Key: string(model.LeaderboardMetricKills), Value: -1}},
+			Options: options.Index().SetName("gameModeId_window_periodStart_kills"),
+		},
+		{
+			Keys: bson.D{{Key: "gameModeId", Value: 1}, {Key: "window", Value: 1}, {Key: "periodStart", Value: 1},
+				{Key: string(model.LeaderboardMetricGamesPlayed), Value: -1}},
+			Options: options.Index().SetName("gameModeId_window_periodStart_gamesPlayed"),
+		},
+		{
+			// Used when deleting old periods
+			Keys:    bson.D{{Key: "window", Value: 1}, {Key: "periodStart", Value: 1}},
+			Options: options.Index().SetName("window_periodStart"),
+		},
+	}
 )
 
 func (m *mongoRepository) createIndexes(ctx context.Context) {
 	collIndexes := map[*mongo.Collection][]mongo.IndexModel{
 		m.liveGameCollection:     liveGameIndexes,
 		m.historicGameCollection: historicGameIndexes,
+		m.playerStatsCollection:  playerStatsIndexes,
+		m.prunedStatsCollection:  playerStatsIndexes,
+		m.mapStatsCollection:     mapStatsIndexes,
+		m.prunedMapStatsColl:     mapStatsIndexes,
+		m.achievementCollection:  achievementIndexes,
+		m.rewardPayoutCollection: rewardPayoutIndexes,
+		m.reviewCollection:       reviewIndexes,
+		m.quarantineCollection:   quarantineIndexes,
+		m.leaderboardCollection:  leaderboardIndexes,
 	}
 
 	wg := sync.WaitGroup{}
@@ -118,6 +283,22 @@ func (m *mongoRepository) createIndexes(ctx context.Context) {
 	wg.Wait()
 }
 
+func (m *mongoRepository) ExecuteTransaction(ctx context.Context, fn func(ctx mongo.SessionContext) error) error {
+	txnOpts := options.Transaction().SetWriteConcern(writeconcern.Majority())
+
+	session, err := m.database.Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	}, txnOpts)
+
+	return err
+}
+
 func (m *mongoRepository) createCollIndexes(ctx context.Context, coll *mongo.Collection, indexes []mongo.IndexModel) (int, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -172,6 +353,107 @@ func (m *mongoRepository) DeleteLiveGame(ctx context.Context, id primitive.Objec
 	return err
 }
 
+func (m *mongoRepository) ForEachLiveGame(ctx context.Context, gameModeId *string, serverId *string,
+	fn func(change LiveGameChange) error) error {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if gameModeId != nil {
+		filter["gameModeId"] = *gameModeId
+	}
+	if serverId != nil {
+		filter["serverId"] = *serverId
+	}
+
+	cursor, err := m.liveGameCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "startTime", Value: 1}}))
+	if err != nil {
+		return fmt.Errorf("failed to find live games: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		id, _ := cursor.Current.Lookup("_id").ObjectIDOK()
+		game, err := decodeLiveGame(cursor.Current)
+
+		if err := fn(LiveGameChange{Id: id, Game: game, Err: err}); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// liveGameChangeEvent is the part of a change stream event the live game stream needs
+type liveGameChangeEvent struct {
+	OperationType string `bson:"operationType"`
+	DocumentKey   struct {
+		Id primitive.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+	// FullDocument is the game as it is when the event is read, so it is empty if the game was deleted since
+	FullDocument bson.Raw `bson:"fullDocument"`
+}
+
+type mongoLiveGameStream struct {
+	stream *mongo.ChangeStream
+}
+
+func (m *mongoRepository) WatchLiveGames(ctx context.Context) (LiveGameStream, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{
+		"operationType": bson.M{"$in": bson.A{"insert", "update", "replace", "delete"}},
+	}}}}
+
+	stream, err := m.liveGameCollection.Watch(ctx, pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch live games: %w", err)
+	}
+
+	return &mongoLiveGameStream{stream: stream}, nil
+}
+
+func (s *mongoLiveGameStream) Next(ctx context.Context) (LiveGameChange, error) {
+	if !s.stream.Next(ctx) {
+		if err := s.stream.Err(); err != nil {
+			return LiveGameChange{}, fmt.Errorf("live game stream failed: %w", err)
+		}
+		// The stream ends without an error if the collection is dropped or renamed
+		return LiveGameChange{}, fmt.Errorf("live game stream ended")
+	}
+
+	var event liveGameChangeEvent
+	if err := s.stream.Decode(&event); err != nil {
+		return LiveGameChange{}, fmt.Errorf("failed to decode live game change: %w", err)
+	}
+
+	change := LiveGameChange{Id: event.DocumentKey.Id}
+	if event.OperationType != "delete" && len(event.FullDocument) > 0 {
+		change.Game, change.Err = decodeLiveGame(event.FullDocument)
+	}
+
+	return change, nil
+}
+
+func (s *mongoLiveGameStream) Close(ctx context.Context) error {
+	return s.stream.Close(ctx)
+}
+
+func decodeLiveGame(raw bson.Raw) (*model.LiveGame, error) {
+	var game model.LiveGame
+	if err := bson.Unmarshal(raw, &game); err != nil {
+		return nil, fmt.Errorf("failed to decode live game: %w", err)
+	}
+
+	if err := game.ParseGameData(); err != nil {
+		return nil, fmt.Errorf("failed to parse game data: %w", err)
+	}
+
+	return &game, nil
+}
+
 func (m *mongoRepository) SaveHistoricGame(ctx context.Context, game *model.HistoricGame) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -180,6 +462,30 @@ func (m *mongoRepository) SaveHistoricGame(ctx context.Context, game *model.Hist
 	return err
 }
 
+func (m *mongoRepository) ReplaceAbandonedHistoricGame(ctx context.Context, game *model.HistoricGame) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, err := m.historicGameCollection.ReplaceOne(ctx, bson.M{"_id": game.Id, "outcome": model.GameOutcomeAbandoned}, game)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+func (m *mongoRepository) HistoricGameExists(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	count, err := m.historicGameCollection.CountDocuments(ctx, bson.M{"_id": id}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("failed to count historic games: %w", err)
+	}
+
+	return count > 0, nil
+}
+
 func (m *mongoRepository) GetHistoricGame(ctx context.Context, id primitive.ObjectID) (*model.HistoricGame, error) {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -195,3 +501,834 @@ func (m *mongoRepository) GetHistoricGame(ctx context.Context, id primitive.Obje
 
 	return &game, nil
 }
+
+func (m *mongoRepository) GetPlayerHistoricGames(ctx context.Context, query PlayerHistoryQuery) ([]*model.HistoricGame, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"players.id": query.PlayerId}
+	if query.GameModeId != nil {
+		filter["gameModeId"] = *query.GameModeId
+	}
+
+	endTimeFilter := bson.M{}
+	if query.From != nil {
+		endTimeFilter["$gte"] = *query.From
+	}
+	if query.To != nil {
+		endTimeFilter["$lt"] = *query.To
+	}
+	if len(endTimeFilter) > 0 {
+		filter["endTime"] = endTimeFilter
+	}
+
+	if query.After != nil {
+		filter["$or"] = bson.A{
+			bson.M{"endTime": bson.M{"$lt": query.After.EndTime}},
+			bson.M{"endTime": query.After.EndTime, "_id": bson.M{"$lt": query.After.Id}},
+		}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "endTime", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(query.Limit)
+
+	cursor, err := m.historicGameCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find historic games: %w", err)
+	}
+
+	var games []*model.HistoricGame
+	if err := cursor.All(ctx, &games); err != nil {
+		return nil, fmt.Errorf("failed to decode historic games: %w", err)
+	}
+
+	for _, game := range games {
+		if err := game.ParseGameData(); err != nil {
+			return nil, fmt.Errorf("failed to parse game data (id: %s): %w", game.Id.Hex(), err)
+		}
+	}
+
+	return games, nil
+}
+
+func (m *mongoRepository) ForEachHistoricGame(ctx context.Context, query HistoricGameQuery, fn func(game *model.HistoricGame) error) error {
+	filter := bson.M{}
+	if query.GameModeId != nil {
+		filter["gameModeId"] = *query.GameModeId
+	}
+
+	endTimeFilter := bson.M{}
+	if query.From != nil {
+		endTimeFilter["$gte"] = *query.From
+	}
+	if query.To != nil {
+		endTimeFilter["$lt"] = *query.To
+	}
+	if len(endTimeFilter) > 0 {
+		filter["endTime"] = endTimeFilter
+	}
+
+	if query.Summarized != nil {
+		filter["summarizedAt"] = bson.M{"$exists": *query.Summarized}
+	}
+
+	if query.After != nil {
+		filter["$or"] = bson.A{
+			bson.M{"endTime": bson.M{"$gt": query.After.EndTime}},
+			bson.M{"endTime": query.After.EndTime, "_id": bson.M{"$gt": query.After.Id}},
+		}
+	}
+
+	// No timeout as this may iterate the whole collection
+	opts := options.Find().SetSort(bson.D{{Key: "endTime", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := m.historicGameCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return fmt.Errorf("failed to find historic games: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var game model.HistoricGame
+		if err := cursor.Decode(&game); err != nil {
+			return fmt.Errorf("failed to decode historic game: %w", err)
+		}
+
+		if err := game.ParseGameData(); err != nil {
+			return fmt.Errorf("failed to parse game data (id: %s): %w", game.Id.Hex(), err)
+		}
+
+		if err := fn(&game); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+func (m *mongoRepository) ListHistoricGameModes(ctx context.Context) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	results, err := m.historicGameCollection.Distinct(ctx, "gameModeId", bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game modes: %w", err)
+	}
+
+	gameModeIds := make([]string, 0, len(results))
+	for _, r := range results {
+		if id, ok := r.(string); ok {
+			gameModeIds = append(gameModeIds, id)
+		}
+	}
+
+	return gameModeIds, nil
+}
+
+func (m *mongoRepository) SummarizeHistoricGame(ctx context.Context, game *model.HistoricGame) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	set := bson.M{"summarizedAt": game.SummarizedAt}
+	if game.PlayerCounters != nil {
+		set["playerCounters"] = game.PlayerCounters
+	}
+	unset := bson.M{"gameData": "", "gameDataType": "", "rawContent": ""}
+
+	if _, err := m.historicGameCollection.UpdateByID(ctx, game.Id, bson.M{"$set": set, "$unset": unset}); err != nil {
+		return fmt.Errorf("failed to summarize historic game: %w", err)
+	}
+
+	if _, err := m.timelineCollection.DeleteOne(ctx, bson.M{"_id": game.Id}); err != nil {
+		return fmt.Errorf("failed to delete game timeline: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) DeleteHistoricGames(ctx context.Context, ids []primitive.ObjectID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+
+	result, err := m.historicGameCollection.DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete historic games: %w", err)
+	}
+
+	if _, err := m.timelineCollection.DeleteMany(ctx, filter); err != nil {
+		return result.DeletedCount, fmt.Errorf("failed to delete game timelines: %w", err)
+	}
+
+	return result.DeletedCount, nil
+}
+
+func (m *mongoRepository) IncrementPlayerStats(ctx context.Context, stats []*model.PlayerStats) error {
+	return incrementStats(ctx, m.playerStatsCollection, stats)
+}
+
+func (m *mongoRepository) IncrementMapStats(ctx context.Context, stats *model.MapStats) error {
+	return incrementMapStats(ctx, m.mapStatsCollection, stats)
+}
+
+func (m *mongoRepository) IncrementPrunedMapStats(ctx context.Context, stats []*model.MapStats) error {
+	for _, s := range stats {
+		if err := incrementMapStats(ctx, m.prunedMapStatsColl, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) ForEachPrunedMapStats(ctx context.Context, fn func(stats *model.MapStats) error) error {
+	// No timeout as this iterates the whole collection
+	cursor, err := m.prunedMapStatsColl.Find(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("failed to find pruned map stats: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var stats model.MapStats
+		if err := cursor.Decode(&stats); err != nil {
+			return fmt.Errorf("failed to decode pruned map stats: %w", err)
+		}
+
+		if err := fn(&stats); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+func (m *mongoRepository) ReplaceAllMapStats(ctx context.Context, stats []*model.MapStats) error {
+	docs := make([]interface{}, len(stats))
+	for i, s := range stats {
+		docs[i] = s
+	}
+
+	return m.replaceCollection(ctx, m.mapStatsCollection, mapStatsIndexes, docs)
+}
+
+func incrementMapStats(ctx context.Context, coll *mongo.Collection, stats *model.MapStats) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	inc := bson.M{
+		"gamesPlayed":   stats.GamesPlayed,
+		"timedGames":    stats.TimedGames,
+		"totalDuration": stats.TotalDuration,
+	}
+	for color, colorStats := range stats.TeamColors {
+		inc["teamColors."+color+".games"] = colorStats.Games
+		inc["teamColors."+color+".wins"] = colorStats.Wins
+	}
+
+	filter := bson.M{"gameModeId": stats.GameModeId, "mapId": stats.MapId}
+	if _, err := coll.UpdateOne(ctx, filter, bson.M{"$inc": inc}, options.Update().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to increment map stats: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) GetMapStats(ctx context.Context, gameModeId string, mapId *string) ([]*model.MapStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"gameModeId": gameModeId}
+	if mapId != nil {
+		filter["mapId"] = *mapId
+	}
+
+	cursor, err := m.mapStatsCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "gamesPlayed", Value: -1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find map stats: %w", err)
+	}
+
+	var stats []*model.MapStats
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, fmt.Errorf("failed to decode map stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (m *mongoRepository) IncrementPrunedPlayerStats(ctx context.Context, stats []*model.PlayerStats) error {
+	return incrementStats(ctx, m.prunedStatsCollection, stats)
+}
+
+func (m *mongoRepository) ForEachPrunedPlayerStats(ctx context.Context, fn func(stats *model.PlayerStats) error) error {
+	// No timeout as this iterates the whole collection
+	cursor, err := m.prunedStatsCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("failed to find pruned player stats: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var stats model.PlayerStats
+		if err := cursor.Decode(&stats); err != nil {
+			return fmt.Errorf("failed to decode pruned player stats: %w", err)
+		}
+
+		if err := fn(&stats); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+func incrementStats(ctx context.Context, coll *mongo.Collection, stats []*model.PlayerStats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	writes := make([]mongo.WriteModel, len(stats))
+	for i, s := range stats {
+		inc := bson.M{
+			"gamesPlayed": s.GamesPlayed,
+			"wins":        s.Wins,
+			"losses":      s.Losses,
+			"playtime":    s.Playtime,
+		}
+		for k, v := range s.Counters {
+			inc["counters."+k] = v
+		}
+
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"playerId": s.PlayerId, "gameModeId": s.GameModeId}).
+			SetUpdate(bson.M{"$inc": inc}).
+			SetUpsert(true)
+	}
+
+	if _, err := coll.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("failed to increment player stats: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) GetPlayerStats(ctx context.Context, playerId uuid.UUID, gameModeId *string) ([]*model.PlayerStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"playerId": playerId}
+	if gameModeId != nil {
+		filter["gameModeId"] = *gameModeId
+	}
+
+	cursor, err := m.playerStatsCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "gameModeId", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find player stats: %w", err)
+	}
+
+	var stats []*model.PlayerStats
+	if err := cursor.All(ctx, &stats); err != nil {
+		return nil, fmt.Errorf("failed to decode player stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+func (m *mongoRepository) ReplaceAllPlayerStats(ctx context.Context, stats []*model.PlayerStats) error {
+	docs := make([]interface{}, len(stats))
+	for i, s := range stats {
+		docs[i] = s
+	}
+
+	return m.replaceCollection(ctx, m.playerStatsCollection, playerStatsIndexes, docs)
+}
+
+func (m *mongoRepository) ReplaceAllLeaderboardEntries(ctx context.Context, entries []*model.LeaderboardEntry) error {
+	docs := make([]interface{}, len(entries))
+	for i, e := range entries {
+		docs[i] = e
+	}
+
+	return m.replaceCollection(ctx, m.leaderboardCollection, leaderboardIndexes, docs)
+}
+
+// replaceCollection writes the documents to a new collection and renames it over the given collection,
+// so readers see either the old or the new documents but never a partially written collection.
+func (m *mongoRepository) replaceCollection(ctx context.Context, coll *mongo.Collection, indexes []mongo.IndexModel, docs []interface{}) error {
+	tempColl := m.database.Collection(coll.Name() + rebuildCollectionSuffix)
+
+	// Left over if a previous rebuild failed
+	if err := tempColl.Drop(ctx); err != nil {
+		return fmt.Errorf("failed to drop %s: %w", tempColl.Name(), err)
+	}
+
+	if _, err := m.createCollIndexes(ctx, tempColl, indexes); err != nil {
+		return fmt.Errorf("failed to create indexes for %s: %w", tempColl.Name(), err)
+	}
+
+	for start := 0; start < len(docs); start += playerStatsWriteBatchSize {
+		end := min(start+playerStatsWriteBatchSize, len(docs))
+
+		if _, err := tempColl.InsertMany(ctx, docs[start:end]); err != nil {
+			return fmt.Errorf("failed to insert into %s: %w", tempColl.Name(), err)
+		}
+	}
+
+	err := m.database.Client().Database("admin").RunCommand(ctx, bson.D{
+		{Key: "renameCollection", Value: databaseName + "." + tempColl.Name()},
+		{Key: "to", Value: databaseName + "." + coll.Name()},
+		{Key: "dropTarget", Value: true},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to rename %s to %s: %w", tempColl.Name(), coll.Name(), err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) IncrementLeaderboardEntries(ctx context.Context, entries []*model.LeaderboardEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	writes := make([]mongo.WriteModel, len(entries))
+	for i, e := range entries {
+		writes[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"gameModeId": e.GameModeId, "window": e.Window, "periodStart": e.PeriodStart, "playerId": e.PlayerId}).
+			SetUpdate(bson.M{"$inc": bson.M{
+				string(model.LeaderboardMetricWins):        e.Wins,
+				string(model.LeaderboardMetricKills):       e.Kills,
+				string(model.LeaderboardMetricGamesPlayed): e.GamesPlayed,
+			}}).
+			SetUpsert(true)
+	}
+
+	if _, err := m.leaderboardCollection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false)); err != nil {
+		return fmt.Errorf("failed to increment leaderboard entries: %w", err)
+	}
+
+	return nil
+}
+
+func leaderboardFilter(query LeaderboardQuery) bson.M {
+	return bson.M{"gameModeId": query.GameModeId, "window": query.Window, "periodStart": query.PeriodStart}
+}
+
+func (m *mongoRepository) GetLeaderboard(ctx context.Context, query LeaderboardQuery, limit int64) ([]*model.LeaderboardEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := leaderboardFilter(query)
+	filter[string(query.Metric)] = bson.M{"$gt": 0}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: string(query.Metric), Value: -1}, {Key: "playerId", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := m.leaderboardCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find leaderboard entries: %w", err)
+	}
+
+	var entries []*model.LeaderboardEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode leaderboard entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (m *mongoRepository) GetLeaderboardEntry(ctx context.Context, query LeaderboardQuery, playerId uuid.UUID) (*model.LeaderboardEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := leaderboardFilter(query)
+	filter["playerId"] = playerId
+
+	var entry model.LeaderboardEntry
+	if err := m.leaderboardCollection.FindOne(ctx, filter).Decode(&entry); err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard entry: %w", err)
+	}
+
+	return &entry, nil
+}
+
+func (m *mongoRepository) CountLeaderboardEntriesAbove(ctx context.Context, query LeaderboardQuery, score int64) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := leaderboardFilter(query)
+	filter[string(query.Metric)] = bson.M{"$gt": score}
+
+	count, err := m.leaderboardCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count leaderboard entries: %w", err)
+	}
+
+	return count, nil
+}
+
+func (m *mongoRepository) DeleteLeaderboardPeriodsBefore(ctx context.Context, window model.LeaderboardWindow, periodStart time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	result, err := m.leaderboardCollection.DeleteMany(ctx, bson.M{"window": window, "periodStart": bson.M{"$lt": periodStart}})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete leaderboard periods: %w", err)
+	}
+
+	return result.DeletedCount, nil
+}
+
+func (m *mongoRepository) AppendTimelineEntry(ctx context.Context, gameId primitive.ObjectID, entry *model.TimelineEntry, maxEntries int) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$push": bson.M{"entries": bson.M{"$each": bson.A{entry}, "$slice": -maxEntries}}}
+	if _, err := m.timelineCollection.UpdateByID(ctx, gameId, update, options.Update().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to append timeline entry: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) GetGameTimeline(ctx context.Context, gameId primitive.ObjectID) (*model.GameTimeline, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var timeline model.GameTimeline
+	if err := m.timelineCollection.FindOne(ctx, bson.M{"_id": gameId}).Decode(&timeline); err != nil {
+		return nil, fmt.Errorf("failed to get game timeline: %w", err)
+	}
+
+	if err := timeline.ParseGameData(); err != nil {
+		return nil, fmt.Errorf("failed to parse game data: %w", err)
+	}
+
+	return &timeline, nil
+}
+
+func (m *mongoRepository) CreateAchievementUnlock(ctx context.Context, unlock *model.AchievementUnlock) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.achievementCollection.InsertOne(ctx, unlock)
+	return err
+}
+
+func (m *mongoRepository) SetAchievementUnlockError(ctx context.Context, playerId uuid.UUID, achievementId string, grantErr string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"playerId": playerId, "achievementId": achievementId}
+	update := bson.M{"$set": bson.M{"error": grantErr}, "$inc": bson.M{"attempts": 1}}
+	if _, err := m.achievementCollection.UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to set achievement unlock error: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) ClearAchievementUnlockError(ctx context.Context, playerId uuid.UUID, achievementId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"playerId": playerId, "achievementId": achievementId}
+	if _, err := m.achievementCollection.UpdateOne(ctx, filter, bson.M{"$unset": bson.M{"error": ""}}); err != nil {
+		return fmt.Errorf("failed to clear achievement unlock error: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) ListFailedAchievementUnlocks(ctx context.Context, maxAttempts int, limit int64) ([]*model.AchievementUnlock, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"error": bson.M{"$exists": true}, "attempts": bson.M{"$lt": maxAttempts}}
+	cursor, err := m.achievementCollection.Find(ctx, filter, options.Find().SetLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find failed achievement unlocks: %w", err)
+	}
+
+	var unlocks []*model.AchievementUnlock
+	if err := cursor.All(ctx, &unlocks); err != nil {
+		return nil, fmt.Errorf("failed to decode achievement unlocks: %w", err)
+	}
+
+	return unlocks, nil
+}
+
+func (m *mongoRepository) CreateReview(ctx context.Context, review *model.Review) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.reviewCollection.InsertOne(ctx, review)
+	return err
+}
+
+func (m *mongoRepository) ListReviews(ctx context.Context, query ReviewQuery) ([]*model.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if query.GameModeId != nil {
+		filter["gameModeId"] = *query.GameModeId
+	}
+	if query.PlayerId != nil {
+		filter["playerId"] = *query.PlayerId
+	}
+	if !query.IncludeResolved {
+		filter["resolvedAt"] = bson.M{"$exists": false}
+	}
+	if query.Before != nil {
+		filter["_id"] = bson.M{"$lt": *query.Before}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(query.Limit)
+	cursor, err := m.reviewCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviews: %w", err)
+	}
+
+	var reviews []*model.Review
+	if err := cursor.All(ctx, &reviews); err != nil {
+		return nil, fmt.Errorf("failed to decode reviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+func (m *mongoRepository) ResolveReview(ctx context.Context, id primitive.ObjectID, resolvedBy string) (*model.Review, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"_id": id, "resolvedAt": bson.M{"$exists": false}}
+	update := bson.M{
+		"$set":   bson.M{"resolvedAt": time.Now(), "resolvedBy": resolvedBy},
+		"$unset": bson.M{"openKey": ""},
+	}
+
+	var review model.Review
+	err := m.reviewCollection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(&review)
+	if err != nil {
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (m *mongoRepository) QuarantineMessage(ctx context.Context, msg *model.QuarantinedMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.quarantineCollection.InsertOne(ctx, msg)
+	return err
+}
+
+func (m *mongoRepository) ListQuarantinedMessages(ctx context.Context, ids []primitive.ObjectID) ([]*model.QuarantinedMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"replayedAt": bson.M{"$exists": false}}
+	if len(ids) > 0 {
+		filter = bson.M{"_id": bson.M{"$in": ids}}
+	}
+
+	// Oldest first so messages of the same game are replayed in their original order
+	cursor, err := m.quarantineCollection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find quarantined messages: %w", err)
+	}
+
+	var messages []*model.QuarantinedMessage
+	if err := cursor.All(ctx, &messages); err != nil {
+		return nil, fmt.Errorf("failed to decode quarantined messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+func (m *mongoRepository) MarkQuarantinedMessageReplayed(ctx context.Context, id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if _, err := m.quarantineCollection.UpdateByID(ctx, id, bson.M{"$set": bson.M{"replayedAt": time.Now()}}); err != nil {
+		return fmt.Errorf("failed to mark quarantined message replayed: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) CreateRewardPayout(ctx context.Context, payout *model.RewardPayout) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := m.rewardPayoutCollection.InsertOne(ctx, payout)
+	return err
+}
+
+func (m *mongoRepository) SetRewardPayoutError(ctx context.Context, gameId primitive.ObjectID, payoutErr string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"error": payoutErr}, "$inc": bson.M{"attempts": 1}}
+	if _, err := m.rewardPayoutCollection.UpdateByID(ctx, gameId, update); err != nil {
+		return fmt.Errorf("failed to set reward payout error: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) GetRewardPayout(ctx context.Context, gameId primitive.ObjectID) (*model.RewardPayout, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var payout model.RewardPayout
+	if err := m.rewardPayoutCollection.FindOne(ctx, bson.M{"_id": gameId}).Decode(&payout); err != nil {
+		return nil, fmt.Errorf("failed to get reward payout: %w", err)
+	}
+
+	return &payout, nil
+}
+
+func (m *mongoRepository) CompleteRewardPayout(ctx context.Context, gameId primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$unset": bson.M{"pending": "", "error": ""}}
+	if _, err := m.rewardPayoutCollection.UpdateByID(ctx, gameId, update); err != nil {
+		return fmt.Errorf("failed to complete reward payout: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) ClaimRewards(ctx context.Context, gameId primitive.ObjectID, playerIds []uuid.UUID,
+	sendingId string) ([]uuid.UUID, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"rewards.$[reward].sendingId": sendingId}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After).SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{
+			"reward.playerId":  bson.M{"$in": playerIds},
+			"reward.paid":      bson.M{"$ne": true},
+			"reward.sendingId": bson.M{"$exists": false},
+		}},
+	})
+
+	// The update is atomic, so the rewards holding sendingId afterwards are the ones this call claimed
+	var payout model.RewardPayout
+	if err := m.rewardPayoutCollection.FindOneAndUpdate(ctx, bson.M{"_id": gameId}, update, opts).Decode(&payout); err != nil {
+		return nil, fmt.Errorf("failed to claim rewards: %w", err)
+	}
+
+	var claimed []uuid.UUID
+	for _, r := range payout.Rewards {
+		if r.SendingId == sendingId {
+			claimed = append(claimed, r.PlayerId)
+		}
+	}
+
+	return claimed, nil
+}
+
+func (m *mongoRepository) MarkRewardsPaid(ctx context.Context, gameId primitive.ObjectID, sendingId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{
+		"$set":   bson.M{"rewards.$[reward].paid": true},
+		"$unset": bson.M{"rewards.$[reward].sendingId": ""},
+	}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"reward.sendingId": sendingId}},
+	})
+
+	if _, err := m.rewardPayoutCollection.UpdateByID(ctx, gameId, update, opts); err != nil {
+		return fmt.Errorf("failed to mark rewards paid: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) ReleaseRewards(ctx context.Context, gameId primitive.ObjectID, sendingId string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	update := bson.M{"$unset": bson.M{"rewards.$[reward].sendingId": ""}}
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"reward.sendingId": sendingId}},
+	})
+
+	if _, err := m.rewardPayoutCollection.UpdateByID(ctx, gameId, update, opts); err != nil {
+		return fmt.Errorf("failed to release rewards: %w", err)
+	}
+
+	return nil
+}
+
+func (m *mongoRepository) ListPendingRewardPayouts(ctx context.Context, createdBefore time.Time, maxAttempts int,
+	limit int64) ([]*model.RewardPayout, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// $not also matches payouts without attempts, which were interrupted before they could fail
+	filter := bson.M{
+		"pending":   true,
+		"createdAt": bson.M{"$lt": createdBefore},
+		"attempts":  bson.M{"$not": bson.M{"$gte": maxAttempts}},
+	}
+	cursor, err := m.rewardPayoutCollection.Find(ctx, filter, options.Find().SetLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pending reward payouts: %w", err)
+	}
+
+	var payouts []*model.RewardPayout
+	if err := cursor.All(ctx, &payouts); err != nil {
+		return nil, fmt.Errorf("failed to decode reward payouts: %w", err)
+	}
+
+	return payouts, nil
+}
+
+func (m *mongoRepository) AcquireLease(ctx context.Context, name string, holderId string, duration time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	filter := bson.M{
+		"_id": name,
+		"$or": bson.A{
+			bson.M{"holderId": holderId},
+			bson.M{"expiresAt": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"holderId": holderId, "expiresAt": now.Add(duration)}}
+
+	// The upsert fails with a duplicate key if another holder has an unexpired lease
+	_, err := m.leaseCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+
+	return true, nil
+}
@@ -3,15 +3,193 @@ package repository
 import (
 	"context"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
 )
 
 type Repository interface {
+	// ExecuteTransaction runs fn in a transaction, the repository methods called with its ctx are part of it
+	ExecuteTransaction(ctx context.Context, fn func(ctx mongo.SessionContext) error) error
+
 	GetLiveGame(ctx context.Context, id primitive.ObjectID) (*model.LiveGame, error)
 	// SaveLiveGame saves a game (with upsert)
 	SaveLiveGame(ctx context.Context, game *model.LiveGame) error
 	DeleteLiveGame(ctx context.Context, id primitive.ObjectID) error
+	// ForEachLiveGame calls fn for every live game, optionally filtered by game mode and server, ordered by start time.
+	// A game that can't be decoded is passed with its Err set rather than stopping the iteration.
+	// Iteration stops at the first error returned by fn.
+	ForEachLiveGame(ctx context.Context, gameModeId *string, serverId *string, fn func(change LiveGameChange) error) error
+	// WatchLiveGames opens a change stream of the live games saved and deleted by any replica from now on
+	WatchLiveGames(ctx context.Context) (LiveGameStream, error)
 
+	// SaveHistoricGame inserts a historic game, returning a duplicate key error if it already exists
 	SaveHistoricGame(ctx context.Context, game *model.HistoricGame) error
+	// ReplaceAbandonedHistoricGame replaces the stored game if it was abandoned, returning false if it wasn't
+	ReplaceAbandonedHistoricGame(ctx context.Context, game *model.HistoricGame) (bool, error)
+	HistoricGameExists(ctx context.Context, id primitive.ObjectID) (bool, error)
 	GetHistoricGame(ctx context.Context, id primitive.ObjectID) (*model.HistoricGame, error)
+	// AppendTimelineEntry adds an entry to the timeline of a game, keeping at most maxEntries of the newest entries
+	AppendTimelineEntry(ctx context.Context, gameId primitive.ObjectID, entry *model.TimelineEntry, maxEntries int) error
+	GetGameTimeline(ctx context.Context, gameId primitive.ObjectID) (*model.GameTimeline, error)
+
+	// GetPlayerHistoricGames returns the historic games of a player, ordered by end time descending
+	GetPlayerHistoricGames(ctx context.Context, query PlayerHistoryQuery) ([]*model.HistoricGame, error)
+	// ForEachHistoricGame calls fn for every historic game matching the query, ordered by end time ascending.
+	// Iteration stops at the first error.
+	ForEachHistoricGame(ctx context.Context, query HistoricGameQuery, fn func(game *model.HistoricGame) error) error
+	// ListHistoricGameModes returns the ids of every game mode that has historic games
+	ListHistoricGameModes(ctx context.Context) ([]string, error)
+	// SummarizeHistoricGame replaces a stored game with its summary (see model.HistoricGame.Summarize) and deletes its timeline
+	SummarizeHistoricGame(ctx context.Context, game *model.HistoricGame) error
+	// DeleteHistoricGames deletes games along with their timelines, returning how many games were deleted.
+	// Reward payouts are kept as they stop a replayed finish from paying out again.
+	DeleteHistoricGames(ctx context.Context, ids []primitive.ObjectID) (int64, error)
+
+	// IncrementPlayerStats adds the given stats to the stored totals, creating them if needed
+	IncrementPlayerStats(ctx context.Context, stats []*model.PlayerStats) error
+	// GetPlayerStats returns the stats of a player for all game modes, or only the given game mode
+	GetPlayerStats(ctx context.Context, playerId uuid.UUID, gameModeId *string) ([]*model.PlayerStats, error)
+	// ReplaceAllPlayerStats atomically replaces all stored stats with the given stats
+	ReplaceAllPlayerStats(ctx context.Context, stats []*model.PlayerStats) error
+	// IncrementMapStats adds the given stats to the stored totals of the map, creating them if needed
+	IncrementMapStats(ctx context.Context, stats *model.MapStats) error
+	// ReplaceAllMapStats atomically replaces all stored map stats with the given stats
+	ReplaceAllMapStats(ctx context.Context, stats []*model.MapStats) error
+	// IncrementPrunedMapStats adds the map stats of deleted historic games to the totals that rebuilds start from
+	IncrementPrunedMapStats(ctx context.Context, stats []*model.MapStats) error
+	// ForEachPrunedMapStats calls fn for the stats of every map that had historic games deleted
+	ForEachPrunedMapStats(ctx context.Context, fn func(stats *model.MapStats) error) error
+	// GetMapStats returns the stats of every map of a game mode, or only the given map, most played first
+	GetMapStats(ctx context.Context, gameModeId string, mapId *string) ([]*model.MapStats, error)
+
+	// IncrementPrunedPlayerStats adds the stats of deleted historic games to the totals that rebuilds start from
+	IncrementPrunedPlayerStats(ctx context.Context, stats []*model.PlayerStats) error
+	// ForEachPrunedPlayerStats calls fn for the stats of every player and game mode that had historic games deleted
+	ForEachPrunedPlayerStats(ctx context.Context, fn func(stats *model.PlayerStats) error) error
+
+	// CreateAchievementUnlock inserts an unlock, returning a duplicate key error if the player already unlocked the achievement
+	CreateAchievementUnlock(ctx context.Context, unlock *model.AchievementUnlock) error
+	// SetAchievementUnlockError records why granting the badge of an unlock failed and counts the attempt
+	SetAchievementUnlockError(ctx context.Context, playerId uuid.UUID, achievementId string, grantErr string) error
+	// ClearAchievementUnlockError marks the badge of an unlock as granted after a retry
+	ClearAchievementUnlockError(ctx context.Context, playerId uuid.UUID, achievementId string) error
+	// ListFailedAchievementUnlocks returns unlocks whose badge wasn't granted in fewer than maxAttempts attempts
+	ListFailedAchievementUnlocks(ctx context.Context, maxAttempts int, limit int64) ([]*model.AchievementUnlock, error)
+
+	// CreateReview inserts a review, returning a duplicate key error if the same review exists (see model.Review)
+	CreateReview(ctx context.Context, review *model.Review) error
+	// ListReviews returns reviews newest first
+	ListReviews(ctx context.Context, query ReviewQuery) ([]*model.Review, error)
+	// ResolveReview marks an unresolved review as resolved, returning mongo.ErrNoDocuments if there is none
+	ResolveReview(ctx context.Context, id primitive.ObjectID, resolvedBy string) (*model.Review, error)
+
+	// QuarantineMessage inserts a message that failed to be handled, returning a duplicate key error if it was already quarantined
+	QuarantineMessage(ctx context.Context, msg *model.QuarantinedMessage) error
+	// ListQuarantinedMessages returns the messages with the given ids, or all that weren't replayed yet if ids is empty, oldest first
+	ListQuarantinedMessages(ctx context.Context, ids []primitive.ObjectID) ([]*model.QuarantinedMessage, error)
+	MarkQuarantinedMessageReplayed(ctx context.Context, id primitive.ObjectID) error
+
+	// CreateRewardPayout inserts a payout, returning a duplicate key error if the game already has one
+	CreateRewardPayout(ctx context.Context, payout *model.RewardPayout) error
+	GetRewardPayout(ctx context.Context, gameId primitive.ObjectID) (*model.RewardPayout, error)
+	// SetRewardPayoutError records why paying out failed and counts the attempt
+	SetRewardPayoutError(ctx context.Context, gameId primitive.ObjectID, payoutErr string) error
+	// CompleteRewardPayout marks a payout as fully paid, clearing its pending state and error
+	CompleteRewardPayout(ctx context.Context, gameId primitive.ObjectID) error
+	// ClaimRewards sets sendingId on the rewards of the players that aren't paid or being sent yet, returning the
+	// players claimed. Only one caller can claim each reward.
+	ClaimRewards(ctx context.Context, gameId primitive.ObjectID, playerIds []uuid.UUID, sendingId string) ([]uuid.UUID, error)
+	// MarkRewardsPaid marks the rewards claimed with sendingId as paid
+	MarkRewardsPaid(ctx context.Context, gameId primitive.ObjectID, sendingId string) error
+	// ReleaseRewards removes the claim of sendingId, so the rewards can be sent again
+	ReleaseRewards(ctx context.Context, gameId primitive.ObjectID, sendingId string) error
+	// ListPendingRewardPayouts returns payouts created before createdBefore that aren't fully paid, and failed fewer
+	// than maxAttempts times
+	ListPendingRewardPayouts(ctx context.Context, createdBefore time.Time, maxAttempts int, limit int64) ([]*model.RewardPayout, error)
+
+	// IncrementLeaderboardEntries adds the given entries to the stored entries of the same player and period
+	IncrementLeaderboardEntries(ctx context.Context, entries []*model.LeaderboardEntry) error
+	// ReplaceAllLeaderboardEntries atomically replaces all stored entries with the given entries
+	ReplaceAllLeaderboardEntries(ctx context.Context, entries []*model.LeaderboardEntry) error
+	// GetLeaderboard returns the highest scoring entries of a period, excluding entries with a score of 0
+	GetLeaderboard(ctx context.Context, query LeaderboardQuery, limit int64) ([]*model.LeaderboardEntry, error)
+	// GetLeaderboardEntry returns the entry of a player, or mongo.ErrNoDocuments if they have none
+	GetLeaderboardEntry(ctx context.Context, query LeaderboardQuery, playerId uuid.UUID) (*model.LeaderboardEntry, error)
+	// CountLeaderboardEntriesAbove returns how many entries of a period have a higher score than the given score
+	CountLeaderboardEntriesAbove(ctx context.Context, query LeaderboardQuery, score int64) (int64, error)
+	// DeleteLeaderboardPeriodsBefore deletes all entries of a window with a period starting before the given time
+	DeleteLeaderboardPeriodsBefore(ctx context.Context, window model.LeaderboardWindow, periodStart time.Time) (int64, error)
+
+	// AcquireLease takes or renews the named lease for the duration, returning false if another holder has it.
+	// Used so background jobs only run in one replica at a time.
+	AcquireLease(ctx context.Context, name string, holderId string, duration time.Duration) (bool, error)
+}
+
+type LeaderboardQuery struct {
+	GameModeId  string
+	Window      model.LeaderboardWindow
+	PeriodStart time.Time
+	Metric      model.LeaderboardMetric
+}
+
+type PlayerHistoryQuery struct {
+	PlayerId   uuid.UUID
+	GameModeId *string
+
+	// From is inclusive, To is exclusive. Both are compared against the end time of the game.
+	From *time.Time
+	To   *time.Time
+
+	// After is the last game of the previous page, if any
+	After *HistoryCursor
+	Limit int64
+}
+
+type ReviewQuery struct {
+	GameModeId      *string
+	PlayerId        *uuid.UUID
+	IncludeResolved bool
+
+	// Before is the id of the last review already seen
+	Before *primitive.ObjectID
+	Limit  int64
+}
+
+// LiveGameChange is the state of a live game after it changed
+type LiveGameChange struct {
+	Id primitive.ObjectID
+	// Game is nil if the game was deleted or Err is set
+	Game *model.LiveGame
+	// Err is set if the game couldn't be decoded
+	Err error
+}
+
+type LiveGameStream interface {
+	// Next waits for the next change. A change that can't be decoded is returned with its Err set, an error is only
+	// returned if the stream itself failed, after which it must be closed.
+	Next(ctx context.Context) (LiveGameChange, error)
+	Close(ctx context.Context) error
+}
+
+// HistoricGameQuery filters historic games. The zero value matches every game.
+type HistoricGameQuery struct {
+	GameModeId *string
+
+	// From is inclusive, To is exclusive. Both are compared against the end time of the game.
+	From *time.Time
+	To   *time.Time
+
+	// After is the last game already seen, games up to and including it are skipped
+	After *HistoryCursor
+
+	// Summarized only matches games that have (true) or haven't (false) been summarized by retention
+	Summarized *bool
+}
+
+// HistoryCursor identifies a position in a player's history. The id breaks ties between games with the same end time.
+type HistoryCursor struct {
+	EndTime time.Time
+	Id      primitive.ObjectID
 }
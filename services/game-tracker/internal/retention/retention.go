@@ -0,0 +1,201 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+const deleteBatchSize = 500
+
+// leaseName is the lease that only lets one replica prune at a time
+const leaseName = "retention"
+
+// Pruner keeps the historic games collection from growing without bound. Old games are first reduced to a summary
+// and later deleted, per game mode.
+//
+// Only the replica holding the retention lease prunes.
+// Player stats and leaderboards are aggregated when a game finishes so they aren't affected. Deleted games' stats are
+// added to the pruned stats so that a stats rebuild still counts them.
+type Pruner struct {
+	logger *zap.SugaredLogger
+	cfg    config.RetentionConfig
+	repo   repository.Repository
+
+	// instanceId identifies this replica as the holder of the lease
+	instanceId string
+}
+
+// ModeReport is what a single run pruned for a game mode
+type ModeReport struct {
+	GameModeId string
+	Summarized int
+	Deleted    int64
+}
+
+func New(logger *zap.SugaredLogger, cfg config.RetentionConfig, repo repository.Repository, instanceId string) *Pruner {
+	return &Pruner{
+		logger:     logger,
+		cfg:        cfg,
+		repo:       repo,
+		instanceId: instanceId,
+	}
+}
+
+func (p *Pruner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(p.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.prune(ctx, time.Now())
+			}
+		}
+	}()
+}
+
+func (p *Pruner) prune(ctx context.Context, now time.Time) {
+	// The lease outlives a missed run so it isn't passed between replicas every interval
+	held, err := p.repo.AcquireLease(ctx, leaseName, p.instanceId, 2*p.cfg.Interval)
+	if err != nil {
+		p.logger.Errorw("failed to acquire retention lease", "error", err)
+		return
+	}
+	if !held {
+		return
+	}
+
+	gameModeIds, err := p.repo.ListHistoricGameModes(ctx)
+	if err != nil {
+		p.logger.Errorw("failed to list historic game modes", "error", err)
+		return
+	}
+
+	for _, gameModeId := range gameModeIds {
+		report, err := p.pruneMode(ctx, gameModeId, now)
+		if err != nil {
+			p.logger.Errorw("failed to prune historic games", "gameModeId", gameModeId, "error", err)
+		}
+
+		// Report partial progress too, as anything pruned before the error stays pruned
+		if report.Summarized > 0 || report.Deleted > 0 {
+			p.logger.Infow("pruned historic games", "gameModeId", gameModeId, "summarized", report.Summarized,
+				"deleted", report.Deleted)
+		}
+	}
+}
+
+func (p *Pruner) pruneMode(ctx context.Context, gameModeId string, now time.Time) (ModeReport, error) {
+	report := ModeReport{GameModeId: gameModeId}
+
+	// Delete first so games about to be deleted aren't summarized
+	if deleteAfter := p.cfg.DeleteAfter(gameModeId); deleteAfter > 0 {
+		deleted, err := p.deleteGames(ctx, gameModeId, now.Add(-deleteAfter))
+		report.Deleted = deleted
+		if err != nil {
+			return report, err
+		}
+	}
+
+	if summarizeAfter := p.cfg.SummarizeAfter(gameModeId); summarizeAfter > 0 {
+		summarized, err := p.summarizeGames(ctx, gameModeId, now.Add(-summarizeAfter), now)
+		report.Summarized = summarized
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func (p *Pruner) summarizeGames(ctx context.Context, gameModeId string, endedBefore time.Time, now time.Time) (int, error) {
+	summarized := false
+	query := repository.HistoricGameQuery{GameModeId: &gameModeId, To: &endedBefore, Summarized: &summarized}
+
+	count := 0
+	err := p.repo.ForEachHistoricGame(ctx, query, func(game *model.HistoricGame) error {
+		game.Summarize(now)
+
+		if err := p.repo.SummarizeHistoricGame(ctx, game); err != nil {
+			return fmt.Errorf("failed to summarize game %s: %w", game.Id.Hex(), err)
+		}
+
+		count++
+		return nil
+	})
+
+	return count, err
+}
+
+// deleteGames deletes games in batches, adding their player and map stats to the pruned stats in the same transaction so a failure
+// never counts a game twice or loses its stats.
+func (p *Pruner) deleteGames(ctx context.Context, gameModeId string, endedBefore time.Time) (int64, error) {
+	query := repository.HistoricGameQuery{GameModeId: &gameModeId, To: &endedBefore}
+
+	var deleted int64
+	ids := make([]primitive.ObjectID, 0, deleteBatchSize)
+	var stats []*model.PlayerStats
+	var mapStats []*model.MapStats
+
+	flush := func() error {
+		if len(ids) == 0 {
+			return nil
+		}
+
+		var count int64
+		err := p.repo.ExecuteTransaction(ctx, func(ctx mongo.SessionContext) error {
+			if err := p.repo.IncrementPrunedPlayerStats(ctx, stats); err != nil {
+				return fmt.Errorf("failed to save pruned player stats: %w", err)
+			}
+
+			if err := p.repo.IncrementPrunedMapStats(ctx, mapStats); err != nil {
+				return fmt.Errorf("failed to save pruned map stats: %w", err)
+			}
+
+			var err error
+			count, err = p.repo.DeleteHistoricGames(ctx, ids)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		deleted += count
+
+		ids = ids[:0]
+		stats = nil
+		mapStats = nil
+		return nil
+	}
+
+	err := p.repo.ForEachHistoricGame(ctx, query, func(game *model.HistoricGame) error {
+		ids = append(ids, game.Id)
+		stats = append(stats, model.PlayerStatsFromGame(game)...)
+		if s := model.MapStatsFromGame(game); s != nil {
+			mapStats = append(mapStats, s)
+		}
+
+		if len(ids) >= deleteBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return deleted, err
+	}
+
+	return deleted, flush()
+}
@@ -0,0 +1,72 @@
+// Package retry retries the parts of a game finish that call other services.
+//
+// Reward payouts and achievement unlocks are recorded before the mc-player-service is called, so a replayed game
+// finish finds the record and never pays out or unlocks twice. If the call fails, the error is recorded on the record
+// instead, and Run retries it until it succeeds or runs out of attempts. Reward payouts are recorded as pending and
+// only completed once every player is paid, so a payout interrupted by a crash is retried as well.
+package retry
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// leaseName is the lease that only lets one replica retry at a time
+const leaseName = "retry"
+
+// batchSize is the most records of each Retrier retried per run
+const batchSize = 100
+
+// Retrier retries its failed records. Records that failed maxAttempts times are left for someone to look at.
+type Retrier interface {
+	RetryFailed(ctx context.Context, maxAttempts int, limit int64) error
+}
+
+// Run retries failed records every interval in the replica holding the retry lease
+func Run(ctx context.Context, wg *sync.WaitGroup, logger *zap.SugaredLogger, cfg config.RetryConfig,
+	repo repository.Repository, instanceId string, retriers ...Retrier) {
+
+	if len(retriers) == 0 {
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				retry(ctx, logger, cfg, repo, instanceId, retriers)
+			}
+		}
+	}()
+}
+
+func retry(ctx context.Context, logger *zap.SugaredLogger, cfg config.RetryConfig, repo repository.Repository,
+	instanceId string, retriers []Retrier) {
+
+	held, err := repo.AcquireLease(ctx, leaseName, instanceId, 2*cfg.Interval)
+	if err != nil {
+		logger.Errorw("failed to acquire retry lease", "error", err)
+		return
+	}
+	if !held {
+		return
+	}
+
+	for _, r := range retriers {
+		if err := r.RetryFailed(ctx, cfg.MaxAttempts, batchSize); err != nil {
+			logger.Errorw("failed to retry failed records", "error", err)
+		}
+	}
+}
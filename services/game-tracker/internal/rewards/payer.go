@@ -0,0 +1,190 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/mcplayer"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// Payer gives players XP for finished games
+type Payer interface {
+	// PayOut gives the players of the game their XP. Each game is paid out at most once, even if called again.
+	PayOut(ctx context.Context, game *model.HistoricGame) error
+	// RetryFailed pays out the players of payouts that failed or were interrupted, see the retry package
+	RetryFailed(ctx context.Context, maxAttempts int, limit int64) error
+}
+
+// pendingGracePeriod is how long a payout is left to PayOut before retries take over, so they don't retry a payout
+// that is still being paid
+const pendingGracePeriod = time.Minute
+
+type payerImpl struct {
+	logger      *zap.SugaredLogger
+	repo        repository.Repository
+	mcPlayerSvc mcplayer.McPlayerClient
+	rulesByMode map[string]*Rules
+}
+
+func NewPayer(logger *zap.SugaredLogger, repo repository.Repository, mcPlayerSvc mcplayer.McPlayerClient,
+	rules map[string]*Rules) Payer {
+
+	return &payerImpl{
+		logger:      logger,
+		repo:        repo,
+		mcPlayerSvc: mcPlayerSvc,
+		rulesByMode: rules,
+	}
+}
+
+func (p *payerImpl) PayOut(ctx context.Context, game *model.HistoricGame) error {
+	rules, ok := p.rulesByMode[game.GameModeId]
+	if !ok {
+		return nil
+	}
+
+	payout := &model.RewardPayout{
+		GameId:     game.Id,
+		GameModeId: game.GameModeId,
+		CreatedAt:  time.Now(),
+		Pending:    true,
+	}
+	for _, stats := range model.PlayerStatsFromGame(game) {
+		if xp := rules.Calculate(stats); xp > 0 {
+			payout.Rewards = append(payout.Rewards, &model.PlayerReward{PlayerId: stats.PlayerId, Experience: xp})
+		}
+	}
+
+	if len(payout.Rewards) == 0 {
+		return nil
+	}
+
+	// Recorded as pending before paying, see the retry package
+	if err := p.repo.CreateRewardPayout(ctx, payout); err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("failed to create reward payout: %w", err)
+		}
+
+		// A redelivered finish may have been interrupted while paying out, so the rest is paid now
+		payout, err = p.repo.GetRewardPayout(ctx, game.Id)
+		if err != nil {
+			return err
+		}
+		if !payout.Pending {
+			p.logger.Debugw("game already paid out", "gameId", game.Id.Hex())
+			return nil
+		}
+	}
+
+	return p.settle(ctx, payout)
+}
+
+func (p *payerImpl) RetryFailed(ctx context.Context, maxAttempts int, limit int64) error {
+	payouts, err := p.repo.ListPendingRewardPayouts(ctx, time.Now().Add(-pendingGracePeriod), maxAttempts, limit)
+	if err != nil {
+		return err
+	}
+
+	for _, payout := range payouts {
+		if err := p.settle(ctx, payout); err != nil {
+			p.logger.Warnw("failed to retry reward payout", "gameId", payout.GameId.Hex(), "attempts", payout.Attempts+1,
+				"error", err)
+			continue
+		}
+
+		p.logger.Infow("retried reward payout", "gameId", payout.GameId.Hex())
+	}
+
+	return nil
+}
+
+// settle pays what is left of a pending payout, completing it if every player was paid or recording the error otherwise
+func (p *payerImpl) settle(ctx context.Context, payout *model.RewardPayout) error {
+	if err := p.pay(ctx, payout); err != nil {
+		if setErr := p.repo.SetRewardPayoutError(ctx, payout.GameId, err.Error()); setErr != nil {
+			p.logger.Errorw("failed to record reward payout error", "gameId", payout.GameId.Hex(), "error", setErr)
+		}
+
+		return err
+	}
+
+	return p.repo.CompleteRewardPayout(ctx, payout.GameId)
+}
+
+// pay gives the players that weren't paid yet their XP.
+//
+// The mc-player-service can't tell if it already gave XP for a game, so the game tracker decides: rewards are claimed
+// before the call and marked paid after it, and a player is never sent the same reward twice. If the outcome of a call
+// is unknown, e.g. it timed out or marking the rewards paid failed, its rewards keep their claim and are never sent
+// again. Their payout stays pending with an error until someone checks the player's experience transactions for the
+// payout's Reason.
+func (p *payerImpl) pay(ctx context.Context, payout *model.RewardPayout) error {
+	// AddExperienceToPlayers gives every player the same amount, so players are grouped by amount
+	playersByXp := make(map[uint64][]uuid.UUID)
+	var unknown []string
+	for _, r := range payout.Rewards {
+		switch {
+		case r.Paid:
+		case r.SendingId != "":
+			unknown = append(unknown, r.PlayerId.String())
+		default:
+			playersByXp[r.Experience] = append(playersByXp[r.Experience], r.PlayerId)
+		}
+	}
+
+	reason := payout.Reason()
+	for xp, playerIds := range playersByXp {
+		sendingId := uuid.NewString()
+		claimed, err := p.repo.ClaimRewards(ctx, payout.GameId, playerIds, sendingId)
+		if err != nil {
+			return err
+		}
+		if len(claimed) == 0 {
+			continue // Claimed by a concurrent payout
+		}
+
+		idStrings := make([]string, len(claimed))
+		for i, id := range claimed {
+			idStrings[i] = id.String()
+		}
+
+		_, err = p.mcPlayerSvc.AddExperienceToPlayers(ctx, &mcplayer.AddExperienceToPlayersRequest{
+			PlayerIds:  idStrings,
+			Experience: xp,
+			Reason:     reason,
+		})
+		if err != nil {
+			if notApplied(err) {
+				if releaseErr := p.repo.ReleaseRewards(ctx, payout.GameId, sendingId); releaseErr != nil {
+					p.logger.Errorw("failed to release rewards", "gameId", payout.GameId.Hex(), "error", releaseErr)
+				}
+			}
+
+			return fmt.Errorf("failed to add %d experience to players %v: %w", xp, idStrings, err)
+		}
+
+		if err := p.repo.MarkRewardsPaid(ctx, payout.GameId, sendingId); err != nil {
+			return err
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("experience may or may not have been added to players %v, check their transactions", unknown)
+	}
+
+	return nil
+}
+
+// notApplied returns true if the mc-player-service certainly didn't add any experience for the call that failed with err.
+// It validates all player ids before adding experience, and unavailable means the call didn't reach it.
+func notApplied(err error) bool {
+	code := status.Code(err)
+	return code == codes.InvalidArgument || code == codes.Unavailable
+}
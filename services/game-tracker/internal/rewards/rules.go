@@ -0,0 +1,56 @@
+package rewards
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"os"
+)
+
+// Rules is how much XP a player earns from a game of one game mode
+type Rules struct {
+	// Participation is given to every player of the game
+	Participation uint64 `json:"participation"`
+	WinBonus      uint64 `json:"winBonus"`
+	// PerMinute is given for every full minute of the game
+	PerMinute uint64 `json:"perMinute"`
+	// PerCounter is given for every point of a game mode counter, e.g. {"kills": 2, "finalKills": 5} for Block Sumo
+	PerCounter map[string]uint64 `json:"perCounter"`
+
+	// Max caps the XP of a single game, 0 is no cap
+	Max uint64 `json:"max"`
+}
+
+// Calculate returns the XP for the stats a player gained from a single game
+func (r *Rules) Calculate(stats *model.PlayerStats) uint64 {
+	xp := r.Participation
+	xp += uint64(stats.Wins) * r.WinBonus
+	xp += uint64(stats.Playtime.Minutes()) * r.PerMinute
+
+	for counter, amount := range r.PerCounter {
+		if value := stats.Counters[counter]; value > 0 {
+			xp += uint64(value) * amount
+		}
+	}
+
+	if r.Max > 0 {
+		xp = min(xp, r.Max)
+	}
+
+	return xp
+}
+
+// LoadRules reads rules from a JSON object of game mode id to rules
+func LoadRules(path string) (map[string]*Rules, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rewards config: %w", err)
+	}
+
+	var rules map[string]*Rules
+	if err := json.Unmarshal(bytes, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse rewards config: %w", err)
+	}
+
+	return rules, nil
+}
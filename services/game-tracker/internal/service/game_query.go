@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/livegames"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultHistoryPageSize = 20
+	maxHistoryPageSize     = 100
+)
+
+type gameQueryService struct {
+	gametrackerpb.UnimplementedGameQueryServer
+
+	log     *zap.SugaredLogger
+	repo    repository.Repository
+	watcher *livegames.Watcher
+}
+
+func newGameQueryService(log *zap.SugaredLogger, repo repository.Repository, watcher *livegames.Watcher) gametrackerpb.GameQueryServer {
+	return &gameQueryService{
+		log:     log,
+		repo:    repo,
+		watcher: watcher,
+	}
+}
+
+func (s *gameQueryService) ListLiveGames(ctx context.Context, req *gametrackerpb.ListLiveGamesRequest) (*gametrackerpb.ListLiveGamesResponse, error) {
+	// A game that can't be decoded or converted is left out rather than failing the whole list
+	var protoGames []*gametrackerpb.LiveGame
+	err := s.repo.ForEachLiveGame(ctx, req.GameModeId, req.ServerId, func(change repository.LiveGameChange) error {
+		if change.Err != nil {
+			s.log.Errorw("skipping live game that failed to decode", "gameId", change.Id.Hex(), "error", change.Err)
+			return nil
+		}
+
+		protoGame, err := change.Game.ToProto()
+		if err != nil {
+			s.log.Errorw("skipping live game that failed to convert to proto", "gameId", change.Id.Hex(), "error", err)
+			return nil
+		}
+
+		protoGames = append(protoGames, protoGame)
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw("failed to list live games", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list live games")
+	}
+
+	return &gametrackerpb.ListLiveGamesResponse{Games: protoGames}, nil
+}
+
+func (s *gameQueryService) GetHistoricGame(ctx context.Context, req *gametrackerpb.GetHistoricGameRequest) (*gametrackerpb.GetHistoricGameResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.GameId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid game id")
+	}
+
+	game, err := s.repo.GetHistoricGame(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "game not found")
+		}
+
+		s.log.Errorw("failed to get historic game", "gameId", req.GameId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get historic game")
+	}
+
+	protoGame, err := game.ToProto()
+	if err != nil {
+		s.log.Errorw("failed to convert historic game to proto", "gameId", req.GameId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to convert historic game")
+	}
+
+	return &gametrackerpb.GetHistoricGameResponse{Game: protoGame}, nil
+}
+
+func (s *gameQueryService) GetGameTimeline(ctx context.Context, req *gametrackerpb.GetGameTimelineRequest) (*gametrackerpb.GetGameTimelineResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.GameId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid game id")
+	}
+
+	timeline, err := s.repo.GetGameTimeline(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "timeline not found")
+		}
+
+		s.log.Errorw("failed to get game timeline", "gameId", req.GameId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get game timeline")
+	}
+
+	protoTimeline, err := timeline.ToProto()
+	if err != nil {
+		s.log.Errorw("failed to convert game timeline to proto", "gameId", req.GameId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to convert game timeline")
+	}
+
+	return &gametrackerpb.GetGameTimelineResponse{Timeline: protoTimeline}, nil
+}
+
+func (s *gameQueryService) GetPlayerGameHistory(ctx context.Context, req *gametrackerpb.GetPlayerGameHistoryRequest) (*gametrackerpb.GetPlayerGameHistoryResponse, error) {
+	playerId, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid player id")
+	}
+
+	pageSize := int64(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultHistoryPageSize
+	} else if pageSize > maxHistoryPageSize {
+		pageSize = maxHistoryPageSize
+	}
+
+	query := repository.PlayerHistoryQuery{
+		PlayerId:   playerId,
+		GameModeId: req.GameModeId,
+		// Fetch one more than we need so we know if there is another page
+		Limit: pageSize + 1,
+	}
+
+	if req.From != nil {
+		from := req.From.AsTime()
+		query.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		query.To = &to
+	}
+
+	if req.PageToken != nil {
+		cursor, err := decodeHistoryCursor(*req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+
+		query.After = cursor
+	}
+
+	games, err := s.repo.GetPlayerHistoricGames(ctx, query)
+	if err != nil {
+		s.log.Errorw("failed to get player historic games", "playerId", playerId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get player game history")
+	}
+
+	var nextPageToken *string
+	if int64(len(games)) > pageSize {
+		games = games[:pageSize]
+
+		last := games[len(games)-1]
+		token := encodeHistoryCursor(&repository.HistoryCursor{EndTime: last.EndTime, Id: last.Id})
+		nextPageToken = &token
+	}
+
+	protoGames := make([]*gametrackerpb.HistoricGame, len(games))
+	for i, game := range games {
+		protoGame, err := game.ToProto()
+		if err != nil {
+			s.log.Errorw("failed to convert historic game to proto", "gameId", game.Id.Hex(), "error", err)
+			return nil, status.Error(codes.Internal, "failed to convert historic game")
+		}
+
+		protoGames[i] = protoGame
+	}
+
+	return &gametrackerpb.GetPlayerGameHistoryResponse{
+		Games:         protoGames,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// encodeHistoryCursor creates an opaque page token in the format base64("<endTimeMillis>:<gameId>").
+// Mongo stores times with millisecond precision so no precision is lost.
+func encodeHistoryCursor(cursor *repository.HistoryCursor) string {
+	raw := fmt.Sprintf("%d:%s", cursor.EndTime.UnixMilli(), cursor.Id.Hex())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeHistoryCursor(token string) (*repository.HistoryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	millisStr, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed page token")
+	}
+
+	millis, err := strconv.ParseInt(millisStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := primitive.ObjectIDFromHex(idStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.HistoryCursor{EndTime: time.UnixMilli(millis), Id: id}, nil
+}
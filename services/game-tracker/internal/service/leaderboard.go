@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+)
+
+var leaderboardMetrics = map[gametrackerpb.LeaderboardMetric]model.LeaderboardMetric{
+	gametrackerpb.LeaderboardMetric_WINS:         model.LeaderboardMetricWins,
+	gametrackerpb.LeaderboardMetric_KILLS:        model.LeaderboardMetricKills,
+	gametrackerpb.LeaderboardMetric_GAMES_PLAYED: model.LeaderboardMetricGamesPlayed,
+}
+
+var leaderboardWindows = map[gametrackerpb.LeaderboardWindow]model.LeaderboardWindow{
+	gametrackerpb.LeaderboardWindow_ALL_TIME: model.LeaderboardWindowAllTime,
+	gametrackerpb.LeaderboardWindow_MONTHLY:  model.LeaderboardWindowMonthly,
+	gametrackerpb.LeaderboardWindow_WEEKLY:   model.LeaderboardWindowWeekly,
+	gametrackerpb.LeaderboardWindow_DAILY:    model.LeaderboardWindowDaily,
+}
+
+type leaderboardService struct {
+	gametrackerpb.UnimplementedLeaderboardServer
+
+	log  *zap.SugaredLogger
+	repo repository.Repository
+}
+
+func newLeaderboardService(log *zap.SugaredLogger, repo repository.Repository) gametrackerpb.LeaderboardServer {
+	return &leaderboardService{
+		log:  log,
+		repo: repo,
+	}
+}
+
+func (s *leaderboardService) GetLeaderboard(ctx context.Context, req *gametrackerpb.GetLeaderboardRequest) (*gametrackerpb.GetLeaderboardResponse, error) {
+	query, err := createLeaderboardQuery(req.GameModeId, req.Metric, req.Window, req.PreviousPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(req.Limit)
+	if limit == 0 {
+		limit = defaultLeaderboardLimit
+	} else if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+
+	entries, err := s.repo.GetLeaderboard(ctx, query, limit)
+	if err != nil {
+		s.log.Errorw("failed to get leaderboard", "query", query, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get leaderboard")
+	}
+
+	protoEntries := make([]*gametrackerpb.LeaderboardEntry, len(entries))
+	var rank uint32
+	for i, e := range entries {
+		score := e.Score(query.Metric)
+
+		// Players with the same score share a rank, the next score skips the shared places
+		if i == 0 || score != protoEntries[i-1].Score {
+			rank = uint32(i + 1)
+		}
+
+		protoEntries[i] = &gametrackerpb.LeaderboardEntry{
+			Rank:     rank,
+			PlayerId: e.PlayerId.String(),
+			Score:    score,
+		}
+	}
+
+	resp := &gametrackerpb.GetLeaderboardResponse{Entries: protoEntries}
+	if periodEnd := query.Window.NextPeriodStart(query.PeriodStart); periodEnd != nil {
+		resp.PeriodStart = timestamppb.New(query.PeriodStart)
+		resp.PeriodEnd = timestamppb.New(*periodEnd)
+	}
+
+	return resp, nil
+}
+
+func (s *leaderboardService) GetLeaderboardRank(ctx context.Context, req *gametrackerpb.GetLeaderboardRankRequest) (*gametrackerpb.GetLeaderboardRankResponse, error) {
+	playerId, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid player id")
+	}
+
+	query, err := createLeaderboardQuery(req.GameModeId, req.Metric, req.Window, req.PreviousPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.repo.GetLeaderboardEntry(ctx, query, playerId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &gametrackerpb.GetLeaderboardRankResponse{}, nil
+		}
+
+		s.log.Errorw("failed to get leaderboard entry", "query", query, "playerId", playerId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get leaderboard entry")
+	}
+
+	score := entry.Score(query.Metric)
+	if score == 0 {
+		return &gametrackerpb.GetLeaderboardRankResponse{}, nil
+	}
+
+	above, err := s.repo.CountLeaderboardEntriesAbove(ctx, query, score)
+	if err != nil {
+		s.log.Errorw("failed to count leaderboard entries", "query", query, "score", score, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get leaderboard rank")
+	}
+
+	return &gametrackerpb.GetLeaderboardRankResponse{
+		Entry: &gametrackerpb.LeaderboardEntry{
+			Rank:     uint32(above + 1),
+			PlayerId: playerId.String(),
+			Score:    score,
+		},
+	}, nil
+}
+
+func createLeaderboardQuery(gameModeId string, pbMetric gametrackerpb.LeaderboardMetric,
+	pbWindow gametrackerpb.LeaderboardWindow, previous bool) (repository.LeaderboardQuery, error) {
+
+	if gameModeId == "" {
+		return repository.LeaderboardQuery{}, status.Error(codes.InvalidArgument, "game mode id is required")
+	}
+
+	metric, ok := leaderboardMetrics[pbMetric]
+	if !ok {
+		return repository.LeaderboardQuery{}, status.Error(codes.InvalidArgument, "unknown metric")
+	}
+
+	window, ok := leaderboardWindows[pbWindow]
+	if !ok {
+		return repository.LeaderboardQuery{}, status.Error(codes.InvalidArgument, "unknown window")
+	}
+
+	periodStart := window.PeriodStart(time.Now())
+	if previous {
+		periodStart = window.PreviousPeriodStart(periodStart)
+	}
+
+	return repository.LeaderboardQuery{
+		GameModeId:  gameModeId,
+		Window:      window,
+		PeriodStart: periodStart,
+		Metric:      metric,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"errors"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/livegames"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"sort"
+	"time"
+)
+
+func (s *gameQueryService) WatchLiveGames(req *gametrackerpb.WatchLiveGamesRequest, stream gametrackerpb.GameQuery_WatchLiveGamesServer) error {
+	filter, err := newLiveGameFilter(req)
+	if err != nil {
+		return err
+	}
+
+	games, sub, err := s.watcher.Subscribe()
+	if errors.Is(err, livegames.ErrNotReady) {
+		return status.Error(codes.Unavailable, "live games are still loading")
+	}
+	if err != nil {
+		s.log.Errorw("failed to subscribe to live games", "error", err)
+		return status.Error(codes.Internal, "failed to subscribe to live games")
+	}
+	defer sub.Close()
+
+	sort.Slice(games, func(i, j int) bool { return games[i].Id.Timestamp().Before(games[j].Id.Timestamp()) })
+
+	// sent are the games the client knows about, so it is told when they stop matching
+	sent := make(map[primitive.ObjectID]bool)
+	for _, g := range games {
+		if !filter.matches(g) {
+			continue
+		}
+
+		if err := s.sendLiveGameEvent(stream, gametrackerpb.LiveGameEvent_ADDED, g); err != nil {
+			return err
+		}
+		sent[g.Id] = true
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case events, ok := <-sub.Events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "fell too far behind live game changes")
+			}
+
+			for _, e := range events {
+				eventType, send := eventForClient(e, filter.matches(e.Game), sent[e.Game.Id])
+				if !send {
+					continue
+				}
+
+				if err := s.sendLiveGameEvent(stream, eventType, e.Game); err != nil {
+					return err
+				}
+				sent[e.Game.Id] = eventType != gametrackerpb.LiveGameEvent_REMOVED
+			}
+		}
+	}
+}
+
+// eventForClient returns the event to send a client that has (sent) or hasn't been sent the game before,
+// as a game that starts or stops matching the filter is added or removed from the client's point of view.
+func eventForClient(e livegames.Event, matches bool, sent bool) (gametrackerpb.LiveGameEvent_Type, bool) {
+	if e.Type == livegames.EventRemoved || !matches {
+		return gametrackerpb.LiveGameEvent_REMOVED, sent
+	}
+
+	if sent {
+		return gametrackerpb.LiveGameEvent_UPDATED, true
+	}
+	return gametrackerpb.LiveGameEvent_ADDED, true
+}
+
+func (s *gameQueryService) sendLiveGameEvent(stream gametrackerpb.GameQuery_WatchLiveGamesServer,
+	eventType gametrackerpb.LiveGameEvent_Type, g *model.LiveGame) error {
+
+	protoGame, err := g.ToProto()
+	if err != nil {
+		s.log.Errorw("failed to convert live game to proto", "gameId", g.Id.Hex(), "error", err)
+		return status.Error(codes.Internal, "failed to convert live game")
+	}
+
+	event := &gametrackerpb.LiveGameEvent{Type: eventType, Game: protoGame}
+	if g.StartTime != nil {
+		event.Elapsed = durationpb.New(time.Since(*g.StartTime))
+	}
+
+	return stream.Send(event)
+}
+
+type liveGameFilter struct {
+	gameModeId *string
+	// playerIds is nil if games aren't filtered by player
+	playerIds map[uuid.UUID]bool
+}
+
+func newLiveGameFilter(req *gametrackerpb.WatchLiveGamesRequest) (*liveGameFilter, error) {
+	filter := &liveGameFilter{gameModeId: req.GameModeId}
+
+	if len(req.PlayerIds) > 0 {
+		filter.playerIds = make(map[uuid.UUID]bool, len(req.PlayerIds))
+		for _, idStr := range req.PlayerIds {
+			id, err := uuid.Parse(idStr)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid player id")
+			}
+
+			filter.playerIds[id] = true
+		}
+	}
+
+	return filter, nil
+}
+
+func (f *liveGameFilter) matches(g *model.LiveGame) bool {
+	if f.gameModeId != nil && g.GameModeId != *f.gameModeId {
+		return false
+	}
+
+	if f.playerIds == nil {
+		return true
+	}
+
+	for _, p := range g.Players {
+		if f.playerIds[p.Id] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mapAnalyticsService struct {
+	gametrackerpb.UnimplementedMapAnalyticsServer
+
+	log  *zap.SugaredLogger
+	repo repository.Repository
+}
+
+func newMapAnalyticsService(log *zap.SugaredLogger, repo repository.Repository) gametrackerpb.MapAnalyticsServer {
+	return &mapAnalyticsService{
+		log:  log,
+		repo: repo,
+	}
+}
+
+func (s *mapAnalyticsService) GetMapStats(ctx context.Context, req *gametrackerpb.GetMapStatsRequest) (*gametrackerpb.GetMapStatsResponse, error) {
+	if req.GameModeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "game mode id is required")
+	}
+
+	stats, err := s.repo.GetMapStats(ctx, req.GameModeId, req.MapId)
+	if err != nil {
+		s.log.Errorw("failed to get map stats", "gameModeId", req.GameModeId, "mapId", req.MapId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get map stats")
+	}
+
+	protoStats := make([]*gametrackerpb.MapStats, len(stats))
+	for i, stat := range stats {
+		protoStats[i] = stat.ToProto()
+	}
+
+	return &gametrackerpb.GetMapStatsResponse{Maps: protoStats}, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type playerStatsService struct {
+	gametrackerpb.UnimplementedPlayerStatsServer
+
+	log  *zap.SugaredLogger
+	repo repository.Repository
+}
+
+func newPlayerStatsService(log *zap.SugaredLogger, repo repository.Repository) gametrackerpb.PlayerStatsServer {
+	return &playerStatsService{
+		log:  log,
+		repo: repo,
+	}
+}
+
+func (s *playerStatsService) GetPlayerStats(ctx context.Context, req *gametrackerpb.GetPlayerStatsRequest) (*gametrackerpb.GetPlayerStatsResponse, error) {
+	playerId, err := uuid.Parse(req.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid player id")
+	}
+
+	stats, err := s.repo.GetPlayerStats(ctx, playerId, req.GameModeId)
+	if err != nil {
+		s.log.Errorw("failed to get player stats", "playerId", playerId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to get player stats")
+	}
+
+	protoStats := make([]*gametrackerpb.PlayerGameModeStats, len(stats))
+	for i, stat := range stats {
+		protoStats[i] = stat.ToProto()
+	}
+
+	return &gametrackerpb.GetPlayerStatsResponse{Stats: protoStats}, nil
+}
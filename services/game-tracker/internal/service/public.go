@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/livegames"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	grpczap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"net"
+	"sync"
+)
+
+func RunServices(ctx context.Context, logger *zap.SugaredLogger, wg *sync.WaitGroup, cfg config.Config,
+	repo repository.Repository, watcher *livegames.Watcher) {
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
+	if err != nil {
+		logger.Fatalw("failed to listen", err)
+	}
+
+	levels := grpczap.WithLevels(func(code codes.Code) zapcore.Level {
+		if code != codes.Internal && code != codes.Unavailable && code != codes.Unknown {
+			return zapcore.DebugLevel
+		} else {
+			return zapcore.ErrorLevel
+		}
+	})
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpczap.UnaryServerInterceptor(logger.Desugar(), levels)),
+		grpc.ChainStreamInterceptor(grpczap.StreamServerInterceptor(logger.Desugar(), levels)),
+	)
+
+	if cfg.Development {
+		reflection.Register(s)
+	}
+
+	gametrackerpb.RegisterGameQueryServer(s, newGameQueryService(logger, repo, watcher))
+	gametrackerpb.RegisterPlayerStatsServer(s, newPlayerStatsService(logger, repo))
+	gametrackerpb.RegisterLeaderboardServer(s, newLeaderboardService(logger, repo))
+	gametrackerpb.RegisterMapAnalyticsServer(s, newMapAnalyticsService(logger, repo))
+	gametrackerpb.RegisterReviewQueueServer(s, newReviewQueueService(logger, repo))
+	logger.Infow("listening for gRPC requests", "port", cfg.GRPCPort)
+
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			logger.Fatalw("failed to serve", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+}
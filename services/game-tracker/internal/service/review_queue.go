@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultReviewPageSize = 20
+	maxReviewPageSize     = 100
+)
+
+type reviewQueueService struct {
+	gametrackerpb.UnimplementedReviewQueueServer
+
+	log  *zap.SugaredLogger
+	repo repository.Repository
+}
+
+func newReviewQueueService(log *zap.SugaredLogger, repo repository.Repository) gametrackerpb.ReviewQueueServer {
+	return &reviewQueueService{
+		log:  log,
+		repo: repo,
+	}
+}
+
+func (s *reviewQueueService) ListReviews(ctx context.Context, req *gametrackerpb.ListReviewsRequest) (*gametrackerpb.ListReviewsResponse, error) {
+	pageSize := int64(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultReviewPageSize
+	} else if pageSize > maxReviewPageSize {
+		pageSize = maxReviewPageSize
+	}
+
+	query := repository.ReviewQuery{
+		GameModeId:      req.GameModeId,
+		IncludeResolved: req.IncludeResolved,
+		// Fetch one more than we need so we know if there is another page
+		Limit: pageSize + 1,
+	}
+
+	if req.PlayerId != nil {
+		playerId, err := uuid.Parse(*req.PlayerId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid player id")
+		}
+		query.PlayerId = &playerId
+	}
+
+	// Review ids are increasing, so the last id of a page is all that's needed to continue
+	if req.PageToken != nil {
+		before, err := primitive.ObjectIDFromHex(*req.PageToken)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		query.Before = &before
+	}
+
+	reviews, err := s.repo.ListReviews(ctx, query)
+	if err != nil {
+		s.log.Errorw("failed to list reviews", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list reviews")
+	}
+
+	var nextPageToken *string
+	if int64(len(reviews)) > pageSize {
+		reviews = reviews[:pageSize]
+
+		token := reviews[len(reviews)-1].Id.Hex()
+		nextPageToken = &token
+	}
+
+	protoReviews := make([]*gametrackerpb.Review, len(reviews))
+	for i, r := range reviews {
+		protoReviews[i] = r.ToProto()
+	}
+
+	return &gametrackerpb.ListReviewsResponse{Reviews: protoReviews, NextPageToken: nextPageToken}, nil
+}
+
+func (s *reviewQueueService) ResolveReview(ctx context.Context, req *gametrackerpb.ResolveReviewRequest) (*gametrackerpb.ResolveReviewResponse, error) {
+	id, err := primitive.ObjectIDFromHex(req.ReviewId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid review id")
+	}
+
+	review, err := s.repo.ResolveReview(ctx, id, req.ResolvedBy)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "unresolved review not found")
+		}
+
+		s.log.Errorw("failed to resolve review", "reviewId", req.ReviewId, "error", err)
+		return nil, status.Error(codes.Internal, "failed to resolve review")
+	}
+
+	return &gametrackerpb.ResolveReviewResponse{Review: review.ToProto()}, nil
+}
@@ -0,0 +1,164 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository"
+	"github.com/emortalmc/mono-services/services/game-tracker/internal/repository/model"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+)
+
+type statsKey struct {
+	playerId   uuid.UUID
+	gameModeId string
+}
+
+type mapStatsKey struct {
+	gameModeId string
+	mapId      string
+}
+
+type leaderboardKey struct {
+	gameModeId  string
+	window      model.LeaderboardWindow
+	periodStart time.Time
+	playerId    uuid.UUID
+}
+
+type RebuildResult struct {
+	Games              int
+	PlayerStats        int
+	LeaderboardEntries int
+	MapStats           int
+}
+
+// Rebuild recomputes all player stats, leaderboard entries and map stats from the historic games and replaces the
+// stored ones. Games deleted by retention are included through the pruned stats they left behind, which only count
+// towards the all-time leaderboards as their end times are gone.
+// Games finished while a rebuild is running may be counted twice or not at all, so the consumer should be stopped first.
+func Rebuild(ctx context.Context, logger *zap.SugaredLogger, repo repository.Repository) (RebuildResult, error) {
+	totals := make(map[statsKey]*model.PlayerStats)
+	add := func(s *model.PlayerStats) {
+		key := statsKey{playerId: s.PlayerId, gameModeId: s.GameModeId}
+
+		if total, ok := totals[key]; ok {
+			total.Add(s)
+		} else {
+			totals[key] = s
+		}
+	}
+
+	now := time.Now()
+	entries := make(map[leaderboardKey]*model.LeaderboardEntry)
+	addEntries := func(newEntries []*model.LeaderboardEntry) {
+		for _, e := range newEntries {
+			// Periods the rollover would already have deleted
+			if e.Window != model.LeaderboardWindowAllTime &&
+				e.PeriodStart.Before(e.Window.PreviousPeriodStart(e.Window.PeriodStart(now))) {
+				continue
+			}
+
+			key := leaderboardKey{gameModeId: e.GameModeId, window: e.Window, periodStart: e.PeriodStart, playerId: e.PlayerId}
+			if entry, ok := entries[key]; ok {
+				entry.Wins += e.Wins
+				entry.Kills += e.Kills
+				entry.GamesPlayed += e.GamesPlayed
+			} else {
+				entries[key] = e
+			}
+		}
+	}
+
+	mapTotals := make(map[mapStatsKey]*model.MapStats)
+	addMapStats := func(s *model.MapStats) {
+		key := mapStatsKey{gameModeId: s.GameModeId, mapId: s.MapId}
+
+		if total, ok := mapTotals[key]; ok {
+			total.Add(s)
+		} else {
+			mapTotals[key] = s
+		}
+	}
+
+	err := repo.ForEachPrunedPlayerStats(ctx, func(stats *model.PlayerStats) error {
+		for _, e := range model.LeaderboardEntriesFromStats([]*model.PlayerStats{stats}, now) {
+			if e.Window == model.LeaderboardWindowAllTime {
+				addEntries([]*model.LeaderboardEntry{e})
+			}
+		}
+
+		add(stats)
+		return nil
+	})
+	if err != nil {
+		return RebuildResult{}, fmt.Errorf("failed to load pruned player stats: %w", err)
+	}
+
+	err = repo.ForEachPrunedMapStats(ctx, func(stats *model.MapStats) error {
+		addMapStats(stats)
+		return nil
+	})
+	if err != nil {
+		return RebuildResult{}, fmt.Errorf("failed to load pruned map stats: %w", err)
+	}
+
+	games := 0
+	err = repo.ForEachHistoricGame(ctx, repository.HistoricGameQuery{}, func(game *model.HistoricGame) error {
+		// Entries are created first as add may change the stats
+		gameStats := model.PlayerStatsFromGame(game)
+		addEntries(model.LeaderboardEntriesFromStats(gameStats, game.EndTime))
+		for _, s := range gameStats {
+			add(s)
+		}
+
+		if s := model.MapStatsFromGame(game); s != nil {
+			addMapStats(s)
+		}
+
+		games++
+		if games%10000 == 0 {
+			logger.Infow("aggregated historic games", "games", games)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return RebuildResult{}, fmt.Errorf("failed to aggregate historic games: %w", err)
+	}
+
+	stats := make([]*model.PlayerStats, 0, len(totals))
+	for _, s := range totals {
+		stats = append(stats, s)
+	}
+
+	if err := repo.ReplaceAllPlayerStats(ctx, stats); err != nil {
+		return RebuildResult{}, fmt.Errorf("failed to save player stats: %w", err)
+	}
+
+	leaderboardEntries := make([]*model.LeaderboardEntry, 0, len(entries))
+	for _, e := range entries {
+		leaderboardEntries = append(leaderboardEntries, e)
+	}
+
+	if err := repo.ReplaceAllLeaderboardEntries(ctx, leaderboardEntries); err != nil {
+		return RebuildResult{}, fmt.Errorf("failed to save leaderboard entries: %w", err)
+	}
+
+	mapStats := make([]*model.MapStats, 0, len(mapTotals))
+	for _, s := range mapTotals {
+		mapStats = append(mapStats, s)
+	}
+
+	if err := repo.ReplaceAllMapStats(ctx, mapStats); err != nil {
+		return RebuildResult{}, fmt.Errorf("failed to save map stats: %w", err)
+	}
+
+	return RebuildResult{
+		Games:              games,
+		PlayerStats:        len(stats),
+		LeaderboardEntries: len(leaderboardEntries),
+		MapStats:           len(mapStats),
+	}, nil
+}
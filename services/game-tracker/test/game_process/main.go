@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"github.com/emortalmc/mono-services/services/game-tracker/gen/go/gametrackerpb"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/config"
 	"github.com/emortalmc/mono-services/services/game-tracker/internal/utils"
 	"github.com/emortalmc/proto-specs/gen/go/message/gametracker"
@@ -97,6 +98,13 @@ func (a *app) writeStartMessage() {
 		panic(err)
 	}
 
+	metadata, err := anypb.New(&gametrackerpb.CommonGameMetadata{
+		MatchId: utils.Pointer("test-match-" + uuid.New().String()[:6]),
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	message := &gametracker.GameStartMessage{
 		CommonData: &gametracker.CommonGameData{
 			GameModeId: "tower-defence",
@@ -106,7 +114,7 @@ func (a *app) writeStartMessage() {
 		},
 		StartTime: timestamppb.Now(),
 		MapId:     utils.Pointer("test-map"),
-		Content:   []*anypb.Any{tdStartData, teamStartData},
+		Content:   []*anypb.Any{tdStartData, teamStartData, metadata},
 	}
 
 	a.writeMessages(message)
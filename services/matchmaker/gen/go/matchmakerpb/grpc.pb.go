@@ -0,0 +1,957 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.0
+// source: matchmaker/grpc.proto
+
+package matchmakerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QueueEligibilityErrorResponse_ErrorReason int32
+
+const (
+	// MISSING_PERMISSION a party member doesn't have the permission node required by the game mode
+	QueueEligibilityErrorResponse_MISSING_PERMISSION QueueEligibilityErrorResponse_ErrorReason = 0
+	// LEVEL_TOO_LOW a party member's experience level is lower than required by the game mode
+	QueueEligibilityErrorResponse_LEVEL_TOO_LOW QueueEligibilityErrorResponse_ErrorReason = 1
+	// PARTY_TOO_LARGE_FOR_ROLE the party is larger than the party leader's roles allow for the game mode
+	QueueEligibilityErrorResponse_PARTY_TOO_LARGE_FOR_ROLE QueueEligibilityErrorResponse_ErrorReason = 2
+)
+
+// Enum value maps for QueueEligibilityErrorResponse_ErrorReason.
+var (
+	QueueEligibilityErrorResponse_ErrorReason_name = map[int32]string{
+		0: "MISSING_PERMISSION",
+		1: "LEVEL_TOO_LOW",
+		2: "PARTY_TOO_LARGE_FOR_ROLE",
+	}
+	QueueEligibilityErrorResponse_ErrorReason_value = map[string]int32{
+		"MISSING_PERMISSION":       0,
+		"LEVEL_TOO_LOW":            1,
+		"PARTY_TOO_LARGE_FOR_ROLE": 2,
+	}
+)
+
+func (x QueueEligibilityErrorResponse_ErrorReason) Enum() *QueueEligibilityErrorResponse_ErrorReason {
+	p := new(QueueEligibilityErrorResponse_ErrorReason)
+	*p = x
+	return p
+}
+
+func (x QueueEligibilityErrorResponse_ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueEligibilityErrorResponse_ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_matchmaker_grpc_proto_enumTypes[0].Descriptor()
+}
+
+func (QueueEligibilityErrorResponse_ErrorReason) Type() protoreflect.EnumType {
+	return &file_matchmaker_grpc_proto_enumTypes[0]
+}
+
+func (x QueueEligibilityErrorResponse_ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueEligibilityErrorResponse_ErrorReason.Descriptor instead.
+func (QueueEligibilityErrorResponse_ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type QueueScheduleErrorResponse_ErrorReason int32
+
+const (
+	// GAME_MODE_CLOSED the game mode is outside of its schedule
+	QueueScheduleErrorResponse_GAME_MODE_CLOSED QueueScheduleErrorResponse_ErrorReason = 0
+)
+
+// Enum value maps for QueueScheduleErrorResponse_ErrorReason.
+var (
+	QueueScheduleErrorResponse_ErrorReason_name = map[int32]string{
+		0: "GAME_MODE_CLOSED",
+	}
+	QueueScheduleErrorResponse_ErrorReason_value = map[string]int32{
+		"GAME_MODE_CLOSED": 0,
+	}
+)
+
+func (x QueueScheduleErrorResponse_ErrorReason) Enum() *QueueScheduleErrorResponse_ErrorReason {
+	p := new(QueueScheduleErrorResponse_ErrorReason)
+	*p = x
+	return p
+}
+
+func (x QueueScheduleErrorResponse_ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueScheduleErrorResponse_ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_matchmaker_grpc_proto_enumTypes[1].Descriptor()
+}
+
+func (QueueScheduleErrorResponse_ErrorReason) Type() protoreflect.EnumType {
+	return &file_matchmaker_grpc_proto_enumTypes[1]
+}
+
+func (x QueueScheduleErrorResponse_ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueScheduleErrorResponse_ErrorReason.Descriptor instead.
+func (QueueScheduleErrorResponse_ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type QueueSpectatorErrorResponse_ErrorReason int32
+
+const (
+	QueueSpectatorErrorResponse_ALREADY_IN_QUEUE QueueSpectatorErrorResponse_ErrorReason = 0
+	// TARGET_NOT_IN_GAME the target player isn't in a match
+	QueueSpectatorErrorResponse_TARGET_NOT_IN_GAME QueueSpectatorErrorResponse_ErrorReason = 1
+	// MATCH_NOT_FOUND the match doesn't exist or has finished
+	QueueSpectatorErrorResponse_MATCH_NOT_FOUND QueueSpectatorErrorResponse_ErrorReason = 2
+	// NOT_SPECTATABLE the game mode doesn't support spectators
+	QueueSpectatorErrorResponse_NOT_SPECTATABLE QueueSpectatorErrorResponse_ErrorReason = 3
+)
+
+// Enum value maps for QueueSpectatorErrorResponse_ErrorReason.
+var (
+	QueueSpectatorErrorResponse_ErrorReason_name = map[int32]string{
+		0: "ALREADY_IN_QUEUE",
+		1: "TARGET_NOT_IN_GAME",
+		2: "MATCH_NOT_FOUND",
+		3: "NOT_SPECTATABLE",
+	}
+	QueueSpectatorErrorResponse_ErrorReason_value = map[string]int32{
+		"ALREADY_IN_QUEUE":   0,
+		"TARGET_NOT_IN_GAME": 1,
+		"MATCH_NOT_FOUND":    2,
+		"NOT_SPECTATABLE":    3,
+	}
+)
+
+func (x QueueSpectatorErrorResponse_ErrorReason) Enum() *QueueSpectatorErrorResponse_ErrorReason {
+	p := new(QueueSpectatorErrorResponse_ErrorReason)
+	*p = x
+	return p
+}
+
+func (x QueueSpectatorErrorResponse_ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueueSpectatorErrorResponse_ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_matchmaker_grpc_proto_enumTypes[2].Descriptor()
+}
+
+func (QueueSpectatorErrorResponse_ErrorReason) Type() protoreflect.EnumType {
+	return &file_matchmaker_grpc_proto_enumTypes[2]
+}
+
+func (x QueueSpectatorErrorResponse_ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueueSpectatorErrorResponse_ErrorReason.Descriptor instead.
+func (QueueSpectatorErrorResponse_ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type GetGameModeScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// until defaults to 7 days from now and is capped at 31 days
+	Until *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=until,proto3,oneof" json:"until,omitempty"`
+}
+
+func (x *GetGameModeScheduleRequest) Reset() {
+	*x = GetGameModeScheduleRequest{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameModeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameModeScheduleRequest) ProtoMessage() {}
+
+func (x *GetGameModeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameModeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetGameModeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetGameModeScheduleRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type GetGameModeScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModes []*ScheduledGameMode `protobuf:"bytes,1,rep,name=game_modes,json=gameModes,proto3" json:"game_modes,omitempty"`
+}
+
+func (x *GetGameModeScheduleResponse) Reset() {
+	*x = GetGameModeScheduleResponse{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameModeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameModeScheduleResponse) ProtoMessage() {}
+
+func (x *GetGameModeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameModeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetGameModeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGameModeScheduleResponse) GetGameModes() []*ScheduledGameMode {
+	if x != nil {
+		return x.GameModes
+	}
+	return nil
+}
+
+type ScheduledGameMode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId string `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	// scheduled is false for modes that are always open
+	Scheduled bool `protobuf:"varint,2,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Open      bool `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	// closes_at only present if open and the mode closes before the requested time
+	ClosesAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=closes_at,json=closesAt,proto3,oneof" json:"closes_at,omitempty"`
+	// upcoming the windows the mode opens in after now, before the requested time
+	Upcoming []*OpenWindow `protobuf:"bytes,5,rep,name=upcoming,proto3" json:"upcoming,omitempty"`
+}
+
+func (x *ScheduledGameMode) Reset() {
+	*x = ScheduledGameMode{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledGameMode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledGameMode) ProtoMessage() {}
+
+func (x *ScheduledGameMode) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledGameMode.ProtoReflect.Descriptor instead.
+func (*ScheduledGameMode) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduledGameMode) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *ScheduledGameMode) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
+func (x *ScheduledGameMode) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *ScheduledGameMode) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *ScheduledGameMode) GetUpcoming() []*OpenWindow {
+	if x != nil {
+		return x.Upcoming
+	}
+	return nil
+}
+
+type OpenWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpenTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	// close_time may be the requested time if the mode is still open then
+	CloseTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+}
+
+func (x *OpenWindow) Reset() {
+	*x = OpenWindow{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenWindow) ProtoMessage() {}
+
+func (x *OpenWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenWindow.ProtoReflect.Descriptor instead.
+func (*OpenWindow) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OpenWindow) GetOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenTime
+	}
+	return nil
+}
+
+func (x *OpenWindow) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+// QueueEligibilityErrorResponse is attached as an error detail alongside the QueueByPlayerErrorResponse
+// when a party fails the queue rules of a game mode.
+// Clients that don't know about it can still rely on the QueueByPlayerErrorResponse reason.
+type QueueEligibilityErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason QueueEligibilityErrorResponse_ErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=emortal.matchmaker.grpc.QueueEligibilityErrorResponse_ErrorReason" json:"reason,omitempty"`
+	// player_ids of type (repeated) UUID, the party members that failed the rule
+	PlayerIds []string `protobuf:"bytes,2,rep,name=player_ids,json=playerIds,proto3" json:"player_ids,omitempty"`
+	// required_permission only present for MISSING_PERMISSION
+	RequiredPermission *string `protobuf:"bytes,3,opt,name=required_permission,json=requiredPermission,proto3,oneof" json:"required_permission,omitempty"`
+	// required_level only present for LEVEL_TOO_LOW
+	RequiredLevel *uint32 `protobuf:"varint,4,opt,name=required_level,json=requiredLevel,proto3,oneof" json:"required_level,omitempty"`
+	// max_party_size only present for PARTY_TOO_LARGE_FOR_ROLE
+	MaxPartySize *uint32 `protobuf:"varint,5,opt,name=max_party_size,json=maxPartySize,proto3,oneof" json:"max_party_size,omitempty"`
+}
+
+func (x *QueueEligibilityErrorResponse) Reset() {
+	*x = QueueEligibilityErrorResponse{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueEligibilityErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueEligibilityErrorResponse) ProtoMessage() {}
+
+func (x *QueueEligibilityErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueEligibilityErrorResponse.ProtoReflect.Descriptor instead.
+func (*QueueEligibilityErrorResponse) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueueEligibilityErrorResponse) GetReason() QueueEligibilityErrorResponse_ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return QueueEligibilityErrorResponse_MISSING_PERMISSION
+}
+
+func (x *QueueEligibilityErrorResponse) GetPlayerIds() []string {
+	if x != nil {
+		return x.PlayerIds
+	}
+	return nil
+}
+
+func (x *QueueEligibilityErrorResponse) GetRequiredPermission() string {
+	if x != nil && x.RequiredPermission != nil {
+		return *x.RequiredPermission
+	}
+	return ""
+}
+
+func (x *QueueEligibilityErrorResponse) GetRequiredLevel() uint32 {
+	if x != nil && x.RequiredLevel != nil {
+		return *x.RequiredLevel
+	}
+	return 0
+}
+
+func (x *QueueEligibilityErrorResponse) GetMaxPartySize() uint32 {
+	if x != nil && x.MaxPartySize != nil {
+		return *x.MaxPartySize
+	}
+	return 0
+}
+
+// QueueScheduleErrorResponse is attached as an error detail alongside the QueueByPlayerErrorResponse
+// when a game mode is enabled but its schedule doesn't allow queueing right now.
+// Clients that don't know about it still get GAME_MODE_DISABLED from the QueueByPlayerErrorResponse.
+type QueueScheduleErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason QueueScheduleErrorResponse_ErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=emortal.matchmaker.grpc.QueueScheduleErrorResponse_ErrorReason" json:"reason,omitempty"`
+	// next_open_time only present if the mode opens again within the next week
+	NextOpenTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_open_time,json=nextOpenTime,proto3,oneof" json:"next_open_time,omitempty"`
+}
+
+func (x *QueueScheduleErrorResponse) Reset() {
+	*x = QueueScheduleErrorResponse{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueScheduleErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueScheduleErrorResponse) ProtoMessage() {}
+
+func (x *QueueScheduleErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueScheduleErrorResponse.ProtoReflect.Descriptor instead.
+func (*QueueScheduleErrorResponse) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueueScheduleErrorResponse) GetReason() QueueScheduleErrorResponse_ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return QueueScheduleErrorResponse_GAME_MODE_CLOSED
+}
+
+func (x *QueueScheduleErrorResponse) GetNextOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOpenTime
+	}
+	return nil
+}
+
+type QueueSpectatorByPlayerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// player_id of type UUID
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Types that are assignable to Target:
+	//	*QueueSpectatorByPlayerRequest_TargetPlayerId
+	//	*QueueSpectatorByPlayerRequest_MatchId
+	Target isQueueSpectatorByPlayerRequest_Target `protobuf_oneof:"target"`
+}
+
+func (x *QueueSpectatorByPlayerRequest) Reset() {
+	*x = QueueSpectatorByPlayerRequest{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueSpectatorByPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueSpectatorByPlayerRequest) ProtoMessage() {}
+
+func (x *QueueSpectatorByPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueSpectatorByPlayerRequest.ProtoReflect.Descriptor instead.
+func (*QueueSpectatorByPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueueSpectatorByPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (m *QueueSpectatorByPlayerRequest) GetTarget() isQueueSpectatorByPlayerRequest_Target {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (x *QueueSpectatorByPlayerRequest) GetTargetPlayerId() string {
+	if x, ok := x.GetTarget().(*QueueSpectatorByPlayerRequest_TargetPlayerId); ok {
+		return x.TargetPlayerId
+	}
+	return ""
+}
+
+func (x *QueueSpectatorByPlayerRequest) GetMatchId() string {
+	if x, ok := x.GetTarget().(*QueueSpectatorByPlayerRequest_MatchId); ok {
+		return x.MatchId
+	}
+	return ""
+}
+
+type isQueueSpectatorByPlayerRequest_Target interface {
+	isQueueSpectatorByPlayerRequest_Target()
+}
+
+type QueueSpectatorByPlayerRequest_TargetPlayerId struct {
+	// target_player_id of type UUID, spectate the match this player is in
+	TargetPlayerId string `protobuf:"bytes,2,opt,name=target_player_id,json=targetPlayerId,proto3,oneof"`
+}
+
+type QueueSpectatorByPlayerRequest_MatchId struct {
+	MatchId string `protobuf:"bytes,3,opt,name=match_id,json=matchId,proto3,oneof"`
+}
+
+func (*QueueSpectatorByPlayerRequest_TargetPlayerId) isQueueSpectatorByPlayerRequest_Target() {}
+
+func (*QueueSpectatorByPlayerRequest_MatchId) isQueueSpectatorByPlayerRequest_Target() {}
+
+type QueueSpectatorByPlayerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatchId    string `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	GameModeId string `protobuf:"bytes,2,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+}
+
+func (x *QueueSpectatorByPlayerResponse) Reset() {
+	*x = QueueSpectatorByPlayerResponse{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueSpectatorByPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueSpectatorByPlayerResponse) ProtoMessage() {}
+
+func (x *QueueSpectatorByPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueSpectatorByPlayerResponse.ProtoReflect.Descriptor instead.
+func (*QueueSpectatorByPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueueSpectatorByPlayerResponse) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *QueueSpectatorByPlayerResponse) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+type QueueSpectatorErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason QueueSpectatorErrorResponse_ErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=emortal.matchmaker.grpc.QueueSpectatorErrorResponse_ErrorReason" json:"reason,omitempty"`
+}
+
+func (x *QueueSpectatorErrorResponse) Reset() {
+	*x = QueueSpectatorErrorResponse{}
+	mi := &file_matchmaker_grpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueSpectatorErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueSpectatorErrorResponse) ProtoMessage() {}
+
+func (x *QueueSpectatorErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_grpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueSpectatorErrorResponse.ProtoReflect.Descriptor instead.
+func (*QueueSpectatorErrorResponse) Descriptor() ([]byte, []int) {
+	return file_matchmaker_grpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueueSpectatorErrorResponse) GetReason() QueueSpectatorErrorResponse_ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return QueueSpectatorErrorResponse_ALREADY_IN_QUEUE
+}
+
+var File_matchmaker_grpc_proto protoreflect.FileDescriptor
+
+var file_matchmaker_grpc_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x5d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x35, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x05, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x22, 0x68, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x11, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x6f, 0x70, 0x65, 0x6e, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x3f, 0x0a, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x08, 0x75, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x37, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xbd, 0x03, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6c,
+	0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x42, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x12, 0x34, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x01, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x22, 0x56,
+	0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x12, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x54,
+	0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x41, 0x52, 0x54,
+	0x59, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x41, 0x52, 0x47, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x5f,
+	0x52, 0x4f, 0x4c, 0x45, 0x10, 0x02, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0xf4, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x3f, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0e,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x22, 0x23, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x00, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x1d,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x49, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x5d, 0x0a,
+	0x1e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x42,
+	0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0xde, 0x01, 0x0a,
+	0x1b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x65,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65,
+	0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x49, 0x4e, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54,
+	0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x4d,
+	0x45, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x53, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x32, 0x95, 0x01,
+	0x0a, 0x10, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x33, 0x2e, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d,
+	0x61, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x97, 0x01, 0x0a, 0x09, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x89, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x36,
+	0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61,
+	0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x42,
+	0x79, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x81, 0x01, 0x0a, 0x1f, 0x64, 0x65, 0x76, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61,
+	0x6b, 0x65, 0x72, 0x42, 0x12, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x45,
+	0x78, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x6d, 0x63, 0x2f, 0x6d, 0x6f,
+	0x6e, 0x6f, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65,
+	0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_matchmaker_grpc_proto_rawDescOnce sync.Once
+	file_matchmaker_grpc_proto_rawDescData = file_matchmaker_grpc_proto_rawDesc
+)
+
+func file_matchmaker_grpc_proto_rawDescGZIP() []byte {
+	file_matchmaker_grpc_proto_rawDescOnce.Do(func() {
+		file_matchmaker_grpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_matchmaker_grpc_proto_rawDescData)
+	})
+	return file_matchmaker_grpc_proto_rawDescData
+}
+
+var file_matchmaker_grpc_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_matchmaker_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_matchmaker_grpc_proto_goTypes = []any{
+	(QueueEligibilityErrorResponse_ErrorReason)(0), // 0: emortal.matchmaker.grpc.QueueEligibilityErrorResponse.ErrorReason
+	(QueueScheduleErrorResponse_ErrorReason)(0),    // 1: emortal.matchmaker.grpc.QueueScheduleErrorResponse.ErrorReason
+	(QueueSpectatorErrorResponse_ErrorReason)(0),   // 2: emortal.matchmaker.grpc.QueueSpectatorErrorResponse.ErrorReason
+	(*GetGameModeScheduleRequest)(nil),             // 3: emortal.matchmaker.grpc.GetGameModeScheduleRequest
+	(*GetGameModeScheduleResponse)(nil),            // 4: emortal.matchmaker.grpc.GetGameModeScheduleResponse
+	(*ScheduledGameMode)(nil),                      // 5: emortal.matchmaker.grpc.ScheduledGameMode
+	(*OpenWindow)(nil),                             // 6: emortal.matchmaker.grpc.OpenWindow
+	(*QueueEligibilityErrorResponse)(nil),          // 7: emortal.matchmaker.grpc.QueueEligibilityErrorResponse
+	(*QueueScheduleErrorResponse)(nil),             // 8: emortal.matchmaker.grpc.QueueScheduleErrorResponse
+	(*QueueSpectatorByPlayerRequest)(nil),          // 9: emortal.matchmaker.grpc.QueueSpectatorByPlayerRequest
+	(*QueueSpectatorByPlayerResponse)(nil),         // 10: emortal.matchmaker.grpc.QueueSpectatorByPlayerResponse
+	(*QueueSpectatorErrorResponse)(nil),            // 11: emortal.matchmaker.grpc.QueueSpectatorErrorResponse
+	(*timestamppb.Timestamp)(nil),                  // 12: google.protobuf.Timestamp
+}
+var file_matchmaker_grpc_proto_depIdxs = []int32{
+	12, // 0: emortal.matchmaker.grpc.GetGameModeScheduleRequest.until:type_name -> google.protobuf.Timestamp
+	5,  // 1: emortal.matchmaker.grpc.GetGameModeScheduleResponse.game_modes:type_name -> emortal.matchmaker.grpc.ScheduledGameMode
+	12, // 2: emortal.matchmaker.grpc.ScheduledGameMode.closes_at:type_name -> google.protobuf.Timestamp
+	6,  // 3: emortal.matchmaker.grpc.ScheduledGameMode.upcoming:type_name -> emortal.matchmaker.grpc.OpenWindow
+	12, // 4: emortal.matchmaker.grpc.OpenWindow.open_time:type_name -> google.protobuf.Timestamp
+	12, // 5: emortal.matchmaker.grpc.OpenWindow.close_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: emortal.matchmaker.grpc.QueueEligibilityErrorResponse.reason:type_name -> emortal.matchmaker.grpc.QueueEligibilityErrorResponse.ErrorReason
+	1,  // 7: emortal.matchmaker.grpc.QueueScheduleErrorResponse.reason:type_name -> emortal.matchmaker.grpc.QueueScheduleErrorResponse.ErrorReason
+	12, // 8: emortal.matchmaker.grpc.QueueScheduleErrorResponse.next_open_time:type_name -> google.protobuf.Timestamp
+	2,  // 9: emortal.matchmaker.grpc.QueueSpectatorErrorResponse.reason:type_name -> emortal.matchmaker.grpc.QueueSpectatorErrorResponse.ErrorReason
+	3,  // 10: emortal.matchmaker.grpc.GameModeSchedule.GetGameModeSchedule:input_type -> emortal.matchmaker.grpc.GetGameModeScheduleRequest
+	9,  // 11: emortal.matchmaker.grpc.Spectator.QueueSpectatorByPlayer:input_type -> emortal.matchmaker.grpc.QueueSpectatorByPlayerRequest
+	4,  // 12: emortal.matchmaker.grpc.GameModeSchedule.GetGameModeSchedule:output_type -> emortal.matchmaker.grpc.GetGameModeScheduleResponse
+	10, // 13: emortal.matchmaker.grpc.Spectator.QueueSpectatorByPlayer:output_type -> emortal.matchmaker.grpc.QueueSpectatorByPlayerResponse
+	12, // [12:14] is the sub-list for method output_type
+	10, // [10:12] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_matchmaker_grpc_proto_init() }
+func file_matchmaker_grpc_proto_init() {
+	if File_matchmaker_grpc_proto != nil {
+		return
+	}
+	file_matchmaker_grpc_proto_msgTypes[0].OneofWrappers = []any{}
+	file_matchmaker_grpc_proto_msgTypes[2].OneofWrappers = []any{}
+	file_matchmaker_grpc_proto_msgTypes[4].OneofWrappers = []any{}
+	file_matchmaker_grpc_proto_msgTypes[5].OneofWrappers = []any{}
+	file_matchmaker_grpc_proto_msgTypes[6].OneofWrappers = []any{
+		(*QueueSpectatorByPlayerRequest_TargetPlayerId)(nil),
+		(*QueueSpectatorByPlayerRequest_MatchId)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_matchmaker_grpc_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_matchmaker_grpc_proto_goTypes,
+		DependencyIndexes: file_matchmaker_grpc_proto_depIdxs,
+		EnumInfos:         file_matchmaker_grpc_proto_enumTypes,
+		MessageInfos:      file_matchmaker_grpc_proto_msgTypes,
+	}.Build()
+	File_matchmaker_grpc_proto = out.File
+	file_matchmaker_grpc_proto_rawDesc = nil
+	file_matchmaker_grpc_proto_goTypes = nil
+	file_matchmaker_grpc_proto_depIdxs = nil
+}
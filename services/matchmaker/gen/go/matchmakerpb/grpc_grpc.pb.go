@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v5.28.0
+// source: matchmaker/grpc.proto
+
+package matchmakerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GameModeScheduleClient is the client API for GameModeSchedule service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GameModeScheduleClient interface {
+	// GetGameModeSchedule returns every enabled game mode that is open now or opens before the requested time.
+	GetGameModeSchedule(ctx context.Context, in *GetGameModeScheduleRequest, opts ...grpc.CallOption) (*GetGameModeScheduleResponse, error)
+}
+
+type gameModeScheduleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGameModeScheduleClient(cc grpc.ClientConnInterface) GameModeScheduleClient {
+	return &gameModeScheduleClient{cc}
+}
+
+func (c *gameModeScheduleClient) GetGameModeSchedule(ctx context.Context, in *GetGameModeScheduleRequest, opts ...grpc.CallOption) (*GetGameModeScheduleResponse, error) {
+	out := new(GetGameModeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/emortal.matchmaker.grpc.GameModeSchedule/GetGameModeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GameModeScheduleServer is the server API for GameModeSchedule service.
+// All implementations must embed UnimplementedGameModeScheduleServer
+// for forward compatibility
+type GameModeScheduleServer interface {
+	// GetGameModeSchedule returns every enabled game mode that is open now or opens before the requested time.
+	GetGameModeSchedule(context.Context, *GetGameModeScheduleRequest) (*GetGameModeScheduleResponse, error)
+	mustEmbedUnimplementedGameModeScheduleServer()
+}
+
+// UnimplementedGameModeScheduleServer must be embedded to have forward compatible implementations.
+type UnimplementedGameModeScheduleServer struct {
+}
+
+func (UnimplementedGameModeScheduleServer) GetGameModeSchedule(context.Context, *GetGameModeScheduleRequest) (*GetGameModeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameModeSchedule not implemented")
+}
+func (UnimplementedGameModeScheduleServer) mustEmbedUnimplementedGameModeScheduleServer() {}
+
+// UnsafeGameModeScheduleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GameModeScheduleServer will
+// result in compilation errors.
+type UnsafeGameModeScheduleServer interface {
+	mustEmbedUnimplementedGameModeScheduleServer()
+}
+
+func RegisterGameModeScheduleServer(s grpc.ServiceRegistrar, srv GameModeScheduleServer) {
+	s.RegisterService(&GameModeSchedule_ServiceDesc, srv)
+}
+
+func _GameModeSchedule_GetGameModeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameModeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameModeScheduleServer).GetGameModeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.matchmaker.grpc.GameModeSchedule/GetGameModeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameModeScheduleServer).GetGameModeSchedule(ctx, req.(*GetGameModeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GameModeSchedule_ServiceDesc is the grpc.ServiceDesc for GameModeSchedule service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GameModeSchedule_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.matchmaker.grpc.GameModeSchedule",
+	HandlerType: (*GameModeScheduleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGameModeSchedule",
+			Handler:    _GameModeSchedule_GetGameModeSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "matchmaker/grpc.proto",
+}
+
+// SpectatorClient is the client API for Spectator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SpectatorClient interface {
+	// QueueSpectatorByPlayer queues a player to spectate a match that is in progress.
+	// The player is sent to the match's server without taking up one of its player slots.
+	QueueSpectatorByPlayer(ctx context.Context, in *QueueSpectatorByPlayerRequest, opts ...grpc.CallOption) (*QueueSpectatorByPlayerResponse, error)
+}
+
+type spectatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSpectatorClient(cc grpc.ClientConnInterface) SpectatorClient {
+	return &spectatorClient{cc}
+}
+
+func (c *spectatorClient) QueueSpectatorByPlayer(ctx context.Context, in *QueueSpectatorByPlayerRequest, opts ...grpc.CallOption) (*QueueSpectatorByPlayerResponse, error) {
+	out := new(QueueSpectatorByPlayerResponse)
+	err := c.cc.Invoke(ctx, "/emortal.matchmaker.grpc.Spectator/QueueSpectatorByPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SpectatorServer is the server API for Spectator service.
+// All implementations must embed UnimplementedSpectatorServer
+// for forward compatibility
+type SpectatorServer interface {
+	// QueueSpectatorByPlayer queues a player to spectate a match that is in progress.
+	// The player is sent to the match's server without taking up one of its player slots.
+	QueueSpectatorByPlayer(context.Context, *QueueSpectatorByPlayerRequest) (*QueueSpectatorByPlayerResponse, error)
+	mustEmbedUnimplementedSpectatorServer()
+}
+
+// UnimplementedSpectatorServer must be embedded to have forward compatible implementations.
+type UnimplementedSpectatorServer struct {
+}
+
+func (UnimplementedSpectatorServer) QueueSpectatorByPlayer(context.Context, *QueueSpectatorByPlayerRequest) (*QueueSpectatorByPlayerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueSpectatorByPlayer not implemented")
+}
+func (UnimplementedSpectatorServer) mustEmbedUnimplementedSpectatorServer() {}
+
+// UnsafeSpectatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SpectatorServer will
+// result in compilation errors.
+type UnsafeSpectatorServer interface {
+	mustEmbedUnimplementedSpectatorServer()
+}
+
+func RegisterSpectatorServer(s grpc.ServiceRegistrar, srv SpectatorServer) {
+	s.RegisterService(&Spectator_ServiceDesc, srv)
+}
+
+func _Spectator_QueueSpectatorByPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueSpectatorByPlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpectatorServer).QueueSpectatorByPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/emortal.matchmaker.grpc.Spectator/QueueSpectatorByPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpectatorServer).QueueSpectatorByPlayer(ctx, req.(*QueueSpectatorByPlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Spectator_ServiceDesc is the grpc.ServiceDesc for Spectator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Spectator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emortal.matchmaker.grpc.Spectator",
+	HandlerType: (*SpectatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueueSpectatorByPlayer",
+			Handler:    _Spectator_QueueSpectatorByPlayer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "matchmaker/grpc.proto",
+}
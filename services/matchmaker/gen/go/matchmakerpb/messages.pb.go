@@ -0,0 +1,427 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.0
+// source: matchmaker/messages.proto
+
+package matchmakerpb
+
+import (
+	matchmaker "github.com/emortalmc/proto-specs/gen/go/model/matchmaker"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SpectatorTicketDeletedMessage_Reason int32
+
+const (
+	SpectatorTicketDeletedMessage_MATCH_FINISHED SpectatorTicketDeletedMessage_Reason = 0
+)
+
+// Enum value maps for SpectatorTicketDeletedMessage_Reason.
+var (
+	SpectatorTicketDeletedMessage_Reason_name = map[int32]string{
+		0: "MATCH_FINISHED",
+	}
+	SpectatorTicketDeletedMessage_Reason_value = map[string]int32{
+		"MATCH_FINISHED": 0,
+	}
+)
+
+func (x SpectatorTicketDeletedMessage_Reason) Enum() *SpectatorTicketDeletedMessage_Reason {
+	p := new(SpectatorTicketDeletedMessage_Reason)
+	*p = x
+	return p
+}
+
+func (x SpectatorTicketDeletedMessage_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpectatorTicketDeletedMessage_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_matchmaker_messages_proto_enumTypes[0].Descriptor()
+}
+
+func (SpectatorTicketDeletedMessage_Reason) Type() protoreflect.EnumType {
+	return &file_matchmaker_messages_proto_enumTypes[0]
+}
+
+func (x SpectatorTicketDeletedMessage_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpectatorTicketDeletedMessage_Reason.Descriptor instead.
+func (SpectatorTicketDeletedMessage_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_matchmaker_messages_proto_rawDescGZIP(), []int{3, 0}
+}
+
+// GameModeClosedMessage is sent when a scheduled game mode closes.
+// A TicketDeletedMessage (reason GAME_MODE_DELETED) is still sent for each ticket so existing consumers clean up.
+type GameModeClosedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameModeId string `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3" json:"game_mode_id,omitempty"`
+	// tickets that were dequeued because the mode closed
+	Tickets []*matchmaker.Ticket `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	// next_open_time only present if the mode opens again within 31 days
+	NextOpenTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_open_time,json=nextOpenTime,proto3,oneof" json:"next_open_time,omitempty"`
+}
+
+func (x *GameModeClosedMessage) Reset() {
+	*x = GameModeClosedMessage{}
+	mi := &file_matchmaker_messages_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameModeClosedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameModeClosedMessage) ProtoMessage() {}
+
+func (x *GameModeClosedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_messages_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameModeClosedMessage.ProtoReflect.Descriptor instead.
+func (*GameModeClosedMessage) Descriptor() ([]byte, []int) {
+	return file_matchmaker_messages_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GameModeClosedMessage) GetGameModeId() string {
+	if x != nil {
+		return x.GameModeId
+	}
+	return ""
+}
+
+func (x *GameModeClosedMessage) GetTickets() []*matchmaker.Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *GameModeClosedMessage) GetNextOpenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOpenTime
+	}
+	return nil
+}
+
+// MatchRejoinedMessage is sent when a player logs in while their match is still running and the game mode allows
+// rejoining. The player should be sent to the match's assignment, as for a MatchCreatedMessage.
+type MatchRejoinedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// player_id of type UUID
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// match has no tickets, only the match's id, game mode, map and assignment
+	Match *matchmaker.Match `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
+}
+
+func (x *MatchRejoinedMessage) Reset() {
+	*x = MatchRejoinedMessage{}
+	mi := &file_matchmaker_messages_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchRejoinedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchRejoinedMessage) ProtoMessage() {}
+
+func (x *MatchRejoinedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_messages_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchRejoinedMessage.ProtoReflect.Descriptor instead.
+func (*MatchRejoinedMessage) Descriptor() ([]byte, []int) {
+	return file_matchmaker_messages_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MatchRejoinedMessage) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MatchRejoinedMessage) GetMatch() *matchmaker.Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// DirectorTriggerMessage wakes the director loops of every matchmaker replica when tickets change.
+// It is only used between matchmaker replicas.
+type DirectorTriggerMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// game_mode_id not present if every loop should wake
+	GameModeId *string `protobuf:"bytes,1,opt,name=game_mode_id,json=gameModeId,proto3,oneof" json:"game_mode_id,omitempty"`
+	// sender_id identifies the replica that sent it, which already woke its own loop
+	SenderId string `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+}
+
+func (x *DirectorTriggerMessage) Reset() {
+	*x = DirectorTriggerMessage{}
+	mi := &file_matchmaker_messages_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectorTriggerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectorTriggerMessage) ProtoMessage() {}
+
+func (x *DirectorTriggerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_messages_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectorTriggerMessage.ProtoReflect.Descriptor instead.
+func (*DirectorTriggerMessage) Descriptor() ([]byte, []int) {
+	return file_matchmaker_messages_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DirectorTriggerMessage) GetGameModeId() string {
+	if x != nil && x.GameModeId != nil {
+		return *x.GameModeId
+	}
+	return ""
+}
+
+func (x *DirectorTriggerMessage) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+// SpectatorTicketDeletedMessage is sent when a spectator ticket is dequeued because its match has already finished.
+// Spectator tickets aren't deleted with a TicketDeletedMessage as none of its reasons describe a finished match.
+type SpectatorTicketDeletedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket *matchmaker.Ticket                   `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Reason SpectatorTicketDeletedMessage_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=emortal.matchmaker.message.SpectatorTicketDeletedMessage_Reason" json:"reason,omitempty"`
+}
+
+func (x *SpectatorTicketDeletedMessage) Reset() {
+	*x = SpectatorTicketDeletedMessage{}
+	mi := &file_matchmaker_messages_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectatorTicketDeletedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectatorTicketDeletedMessage) ProtoMessage() {}
+
+func (x *SpectatorTicketDeletedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_matchmaker_messages_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectatorTicketDeletedMessage.ProtoReflect.Descriptor instead.
+func (*SpectatorTicketDeletedMessage) Descriptor() ([]byte, []int) {
+	return file_matchmaker_messages_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SpectatorTicketDeletedMessage) GetTicket() *matchmaker.Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *SpectatorTicketDeletedMessage) GetReason() SpectatorTicketDeletedMessage_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return SpectatorTicketDeletedMessage_MATCH_FINISHED
+}
+
+var File_matchmaker_messages_proto protoreflect.FileDescriptor
+
+var file_matchmaker_messages_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6b, 0x75, 0x72, 0x75, 0x73, 0x68,
+	0x69, 0x6d, 0x69, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xce, 0x01, 0x0a, 0x15, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6b, 0x75, 0x72, 0x75, 0x73, 0x68, 0x69, 0x6d,
+	0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x6e,
+	0x65, 0x78, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x69, 0x0a, 0x14, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x6b, 0x75, 0x72, 0x75, 0x73, 0x68, 0x69, 0x6d, 0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x6d, 0x0a, 0x16,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x1d,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x6b, 0x75, 0x72, 0x75, 0x73, 0x68, 0x69, 0x6d,
+	0x69, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x58, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x40, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x1c, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x00, 0x42, 0x8b,
+	0x01, 0x0a, 0x22, 0x64, 0x65, 0x76, 0x2e, 0x65, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x19, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65,
+	0x72, 0x45, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x6d, 0x63, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_matchmaker_messages_proto_rawDescOnce sync.Once
+	file_matchmaker_messages_proto_rawDescData = file_matchmaker_messages_proto_rawDesc
+)
+
+func file_matchmaker_messages_proto_rawDescGZIP() []byte {
+	file_matchmaker_messages_proto_rawDescOnce.Do(func() {
+		file_matchmaker_messages_proto_rawDescData = protoimpl.X.CompressGZIP(file_matchmaker_messages_proto_rawDescData)
+	})
+	return file_matchmaker_messages_proto_rawDescData
+}
+
+var file_matchmaker_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_matchmaker_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_matchmaker_messages_proto_goTypes = []any{
+	(SpectatorTicketDeletedMessage_Reason)(0), // 0: emortal.matchmaker.message.SpectatorTicketDeletedMessage.Reason
+	(*GameModeClosedMessage)(nil),             // 1: emortal.matchmaker.message.GameModeClosedMessage
+	(*MatchRejoinedMessage)(nil),              // 2: emortal.matchmaker.message.MatchRejoinedMessage
+	(*DirectorTriggerMessage)(nil),            // 3: emortal.matchmaker.message.DirectorTriggerMessage
+	(*SpectatorTicketDeletedMessage)(nil),     // 4: emortal.matchmaker.message.SpectatorTicketDeletedMessage
+	(*matchmaker.Ticket)(nil),                 // 5: emortal.kurushimi.model.Ticket
+	(*timestamppb.Timestamp)(nil),             // 6: google.protobuf.Timestamp
+	(*matchmaker.Match)(nil),                  // 7: emortal.kurushimi.model.Match
+}
+var file_matchmaker_messages_proto_depIdxs = []int32{
+	5, // 0: emortal.matchmaker.message.GameModeClosedMessage.tickets:type_name -> emortal.kurushimi.model.Ticket
+	6, // 1: emortal.matchmaker.message.GameModeClosedMessage.next_open_time:type_name -> google.protobuf.Timestamp
+	7, // 2: emortal.matchmaker.message.MatchRejoinedMessage.match:type_name -> emortal.kurushimi.model.Match
+	5, // 3: emortal.matchmaker.message.SpectatorTicketDeletedMessage.ticket:type_name -> emortal.kurushimi.model.Ticket
+	0, // 4: emortal.matchmaker.message.SpectatorTicketDeletedMessage.reason:type_name -> emortal.matchmaker.message.SpectatorTicketDeletedMessage.Reason
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_matchmaker_messages_proto_init() }
+func file_matchmaker_messages_proto_init() {
+	if File_matchmaker_messages_proto != nil {
+		return
+	}
+	file_matchmaker_messages_proto_msgTypes[0].OneofWrappers = []any{}
+	file_matchmaker_messages_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_matchmaker_messages_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_matchmaker_messages_proto_goTypes,
+		DependencyIndexes: file_matchmaker_messages_proto_depIdxs,
+		EnumInfos:         file_matchmaker_messages_proto_enumTypes,
+		MessageInfos:      file_matchmaker_messages_proto_msgTypes,
+	}.Build()
+	File_matchmaker_messages_proto = out.File
+	file_matchmaker_messages_proto_rawDesc = nil
+	file_matchmaker_messages_proto_goTypes = nil
+	file_matchmaker_messages_proto_depIdxs = nil
+}
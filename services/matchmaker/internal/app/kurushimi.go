@@ -6,12 +6,17 @@ import (
 	"github.com/emortalmc/live-config-parser/golang/pkg/liveconfig"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/config"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/director"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/eligibility"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/kafka"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/modeconfig"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/service"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/simplecontroller"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/trigger"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/utils/kubernetes"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/mcplayer"
 	"github.com/emortalmc/proto-specs/gen/go/grpc/party"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/permission"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -43,6 +48,11 @@ func Run(cfg config.Config, logger *zap.SugaredLogger) {
 	}
 	logger.Infow("loaded initial gamemodes", "modeCount", len(gameModes), "modes", modeNames)
 
+	modeConfigController, err := modeconfig.NewController(logger, gameModeController, modeconfig.DefaultPath)
+	if err != nil {
+		logger.Fatalw("failed to load game mode matchmaker configs", err)
+	}
+
 	_, agonesClient := kubernetes.CreateClients()
 
 	repo, err := repository.NewMongoRepository(repoCtx, repoWg, logger, cfg.MongoDB)
@@ -52,7 +62,12 @@ func Run(cfg config.Config, logger *zap.SugaredLogger) {
 
 	notifier := kafka.NewKafkaNotifier(ctx, wg, cfg.Kafka, logger)
 
-	kafka.NewConsumer(ctx, wg, cfg.Kafka, logger, repo)
+	directorTrigger, err := kafka.NewDistributedTrigger(ctx, wg, cfg.Kafka, logger, trigger.New())
+	if err != nil {
+		logger.Fatalw("failed to create director trigger", "error", err)
+	}
+
+	kafka.NewConsumer(ctx, wg, cfg.Kafka, logger, repo, directorTrigger, agonesClient.AgonesV1().GameServers(cfg.Namespace))
 
 	err = repo.HealthCheck(ctx, 5*time.Second)
 	if err != nil {
@@ -74,6 +89,18 @@ func Run(cfg config.Config, logger *zap.SugaredLogger) {
 
 	partyService := party.NewPartyServiceClient(pConn)
 
+	permConn, err := grpc.NewClient(fmt.Sprintf("%s:%d", cfg.PermissionService.Host, cfg.PermissionService.Port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatalw("failed to connect to permission service", err)
+	}
+
+	mcPlayerConn, err := grpc.NewClient(fmt.Sprintf("%s:%d", cfg.McPlayerService.Host, cfg.McPlayerService.Port), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Fatalw("failed to connect to mc player service", err)
+	}
+
+	eligibilityChecker := eligibility.NewChecker(permission.NewPermissionServiceClient(permConn), mcplayer.NewMcPlayerClient(mcPlayerConn))
+
 	// Simple controllers
 	lobbyCfg := cfg.Lobby
 	proxyCfg := cfg.Proxy
@@ -84,9 +111,11 @@ func Run(cfg config.Config, logger *zap.SugaredLogger) {
 	velocityCtrl := simplecontroller.NewJoinController(ctx, wg, logger, notifier, allocationClient,
 		proxyCfg.FleetName, "proxy", proxyCfg.MatchRate, proxyCfg.MatchSize)
 
-	service.RunServices(ctx, logger, wg, cfg, repo, notifier, gameModeController, lobbyCtrl, velocityCtrl, partyService, partySettingsService)
+	service.RunServices(ctx, logger, wg, cfg, repo, notifier, gameModeController, modeConfigController, lobbyCtrl, velocityCtrl,
+		partyService, partySettingsService, eligibilityChecker, directorTrigger)
 
-	directR := director.New(logger, repo, notifier, allocationClient, gameModeController)
+	directR := director.New(logger, repo, notifier, allocationClient, gameModeController, modeConfigController,
+		directorTrigger, cfg.DirectorPollRate)
 	directR.Start(ctx)
 
 	wg.Wait()
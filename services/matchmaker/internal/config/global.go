@@ -20,6 +20,12 @@ const (
 	partyServiceSettingsHostFlag = "party-settings-service-host"
 	partyServiceSettingsPortFlag = "party-settings-service-port"
 
+	permissionServiceHostFlag = "permission-service-host"
+	permissionServicePortFlag = "permission-service-port"
+
+	mcPlayerServiceHostFlag = "mc-player-service-host"
+	mcPlayerServicePortFlag = "mc-player-service-port"
+
 	lobbyFleetNameFlag = "lobby-fleet-name"
 	lobbyMatchRateFlag = "lobby-match-rate"
 	lobbyMatchSizeFlag = "lobby-match-size"
@@ -28,6 +34,8 @@ const (
 	proxyMatchRateFlag = "proxy-match-rate"
 	proxyMatchSizeFlag = "proxy-match-size"
 
+	directorPollRateFlag = "director-poll-rate"
+
 	grpcPortFlag    = "port"
 	developmentFlag = "development"
 )
@@ -36,11 +44,16 @@ type Config struct {
 	Kafka   KafkaConfig
 	MongoDB MongoDBConfig
 
-	PartyService PartyServiceConfig
+	PartyService      PartyServiceConfig
+	PermissionService PermissionServiceConfig
+	McPlayerService   McPlayerServiceConfig
 
 	Lobby LobbyConfig
 	Proxy ProxyConfig
 
+	// DirectorPollRate is how often a game mode is run if nothing wakes it.
+	DirectorPollRate time.Duration
+
 	Namespace   string
 	GrpcPort    int
 	Development bool
@@ -63,6 +76,16 @@ type PartyServiceConfig struct {
 	SettingsPort int
 }
 
+type PermissionServiceConfig struct {
+	Host string
+	Port uint16
+}
+
+type McPlayerServiceConfig struct {
+	Host string
+	Port uint16
+}
+
 type LobbyConfig struct {
 	FleetName string
 	MatchRate time.Duration
@@ -86,6 +109,12 @@ func LoadGlobalConfig() Config {
 	viper.SetDefault(partyServicePortFlag, 10006)
 	viper.SetDefault(partyServiceSettingsHostFlag, "localhost")
 	viper.SetDefault(partyServiceSettingsPortFlag, 10006)
+	// PermissionService
+	viper.SetDefault(permissionServiceHostFlag, "localhost")
+	viper.SetDefault(permissionServicePortFlag, 10001)
+	// McPlayerService
+	viper.SetDefault(mcPlayerServiceHostFlag, "localhost")
+	viper.SetDefault(mcPlayerServicePortFlag, 10004)
 	// Lobby
 	viper.SetDefault(lobbyFleetNameFlag, "lobby")
 	viper.SetDefault(lobbyMatchRateFlag, 175_000_000)
@@ -94,6 +123,8 @@ func LoadGlobalConfig() Config {
 	viper.SetDefault(proxyFleetNameFlag, "velocity")
 	viper.SetDefault(proxyMatchRateFlag, 175_000_000)
 	viper.SetDefault(proxyMatchSizeFlag, 50)
+	// Director
+	viper.SetDefault(directorPollRateFlag, 5_000_000_000)
 	// Global
 	viper.SetDefault(namespaceFlag, "emortalmc")
 	viper.SetDefault(grpcPortFlag, 1007)
@@ -106,12 +137,17 @@ func LoadGlobalConfig() Config {
 	pflag.Int32(partyServicePortFlag, viper.GetInt32(partyServicePortFlag), "PartyService port")
 	pflag.String(partyServiceSettingsHostFlag, viper.GetString(partyServiceSettingsHostFlag), "PartyService settings host")
 	pflag.Int32(partyServiceSettingsPortFlag, viper.GetInt32(partyServiceSettingsPortFlag), "PartyService settings port")
+	pflag.String(permissionServiceHostFlag, viper.GetString(permissionServiceHostFlag), "PermissionService host")
+	pflag.Int32(permissionServicePortFlag, viper.GetInt32(permissionServicePortFlag), "PermissionService port")
+	pflag.String(mcPlayerServiceHostFlag, viper.GetString(mcPlayerServiceHostFlag), "McPlayerService host")
+	pflag.Int32(mcPlayerServicePortFlag, viper.GetInt32(mcPlayerServicePortFlag), "McPlayerService port")
 	pflag.String(lobbyFleetNameFlag, viper.GetString(lobbyFleetNameFlag), "Lobby fleet name")
 	pflag.Duration(lobbyMatchRateFlag, viper.GetDuration(lobbyMatchRateFlag), "Delay between creating lobby matches")
 	pflag.Int32(lobbyMatchSizeFlag, viper.GetInt32(lobbyMatchSizeFlag), "Maximum size of a lobby (accounts for players already in the lobby)")
 	pflag.String(proxyFleetNameFlag, viper.GetString(proxyFleetNameFlag), "Proxy fleet name (default velocity)")
 	pflag.Duration(proxyMatchRateFlag, viper.GetDuration(proxyMatchRateFlag), "Delay between creating proxy matches")
 	pflag.Int32(proxyMatchSizeFlag, viper.GetInt32(proxyMatchSizeFlag), "Maximum size of a proxy (accounts for players already in the proxy)")
+	pflag.Duration(directorPollRateFlag, viper.GetDuration(directorPollRateFlag), "Delay between game mode runs when no tickets change")
 	pflag.String(namespaceFlag, viper.GetString(namespaceFlag), "Namespace that the resource is in")
 	pflag.Int32(grpcPortFlag, viper.GetInt32(grpcPortFlag), "gRPC port of THIS service")
 	pflag.Bool(developmentFlag, viper.GetBool(developmentFlag), "Development mode")
@@ -126,12 +162,17 @@ func LoadGlobalConfig() Config {
 	runtime.Must(viper.BindEnv(partyServicePortFlag))
 	runtime.Must(viper.BindEnv(partyServiceSettingsHostFlag))
 	runtime.Must(viper.BindEnv(partyServiceSettingsPortFlag))
+	runtime.Must(viper.BindEnv(permissionServiceHostFlag))
+	runtime.Must(viper.BindEnv(permissionServicePortFlag))
+	runtime.Must(viper.BindEnv(mcPlayerServiceHostFlag))
+	runtime.Must(viper.BindEnv(mcPlayerServicePortFlag))
 	runtime.Must(viper.BindEnv(lobbyFleetNameFlag))
 	runtime.Must(viper.BindEnv(lobbyMatchRateFlag))
 	runtime.Must(viper.BindEnv(lobbyMatchSizeFlag))
 	runtime.Must(viper.BindEnv(proxyFleetNameFlag))
 	runtime.Must(viper.BindEnv(proxyMatchRateFlag))
 	runtime.Must(viper.BindEnv(proxyMatchSizeFlag))
+	runtime.Must(viper.BindEnv(directorPollRateFlag))
 	runtime.Must(viper.BindEnv(namespaceFlag))
 	runtime.Must(viper.BindEnv(grpcPortFlag))
 	runtime.Must(viper.BindEnv(developmentFlag))
@@ -150,6 +191,14 @@ func LoadGlobalConfig() Config {
 			SettingsHost: viper.GetString(partyServiceSettingsHostFlag),
 			SettingsPort: int(viper.GetInt32(partyServiceSettingsPortFlag)),
 		},
+		PermissionService: PermissionServiceConfig{
+			Host: viper.GetString(permissionServiceHostFlag),
+			Port: uint16(viper.GetInt32(permissionServicePortFlag)),
+		},
+		McPlayerService: McPlayerServiceConfig{
+			Host: viper.GetString(mcPlayerServiceHostFlag),
+			Port: uint16(viper.GetInt32(mcPlayerServicePortFlag)),
+		},
 		Lobby: LobbyConfig{
 			FleetName: viper.GetString(lobbyFleetNameFlag),
 			MatchRate: viper.GetDuration(lobbyMatchRateFlag),
@@ -160,8 +209,9 @@ func LoadGlobalConfig() Config {
 			MatchRate: viper.GetDuration(proxyMatchRateFlag),
 			MatchSize: int(viper.GetInt32(proxyMatchSizeFlag)),
 		},
-		Namespace:   viper.GetString(namespaceFlag),
-		GrpcPort:    int(viper.GetInt32(grpcPortFlag)),
-		Development: viper.GetBool(developmentFlag),
+		DirectorPollRate: viper.GetDuration(directorPollRateFlag),
+		Namespace:        viper.GetString(namespaceFlag),
+		GrpcPort:         int(viper.GetInt32(grpcPortFlag)),
+		Development:      viper.GetBool(developmentFlag),
 	}
 }
@@ -10,8 +10,10 @@ import (
 	selector2 "github.com/emortalmc/mono-services/services/matchmaker/internal/gsallocation/selector"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/kafka"
 	matchfunction2 "github.com/emortalmc/mono-services/services/matchmaker/internal/matchfunction"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/modeconfig"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository/model"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/trigger"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/utils"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/utils/protoutils"
 	msg "github.com/emortalmc/proto-specs/gen/go/message/matchmaker"
@@ -19,6 +21,7 @@ import (
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/rand"
 	"reflect"
 	"time"
@@ -36,11 +39,16 @@ type directorImpl struct {
 
 	allocationClient v1.GameServerAllocationInterface
 
-	configs map[string]*liveconfig.GameModeConfig
+	configs           map[string]*liveconfig.GameModeConfig
+	modeCfgController modeconfig.Controller
+
+	trigger  trigger.Trigger
+	pollRate time.Duration
 }
 
 func New(logger *zap.SugaredLogger, repo repository.Repository, notifier kafka.Notifier,
-	allocationClient v1.GameServerAllocationInterface, cfgController liveconfig.GameModeConfigController) Director {
+	allocationClient v1.GameServerAllocationInterface, cfgController liveconfig.GameModeConfigController,
+	modeCfgController modeconfig.Controller, trigger trigger.Trigger, pollRate time.Duration) Director {
 
 	// Filter for only enabled configs
 	configs := cfgController.GetConfigs()
@@ -58,7 +66,11 @@ func New(logger *zap.SugaredLogger, repo repository.Repository, notifier kafka.N
 
 		allocationClient: allocationClient,
 
-		configs: cfgController.GetConfigs(),
+		configs:           cfgController.GetConfigs(),
+		modeCfgController: modeCfgController,
+
+		trigger:  trigger,
+		pollRate: pollRate,
 	}
 
 	cfgController.AddGlobalUpdateListener(d.onGameModeConfigUpdate)
@@ -71,11 +83,32 @@ func (d *directorImpl) Start(ctx context.Context) {
 			ctx, cancel := context.WithCancel(ctx)
 			defer cancel()
 
+			wake := d.trigger.Subscribe(config.Id)
+
 			for {
 				lastRunTime := time.Now()
-				d.run(ctx, config)
+				nextDue := d.run(ctx, config)
+
+				// Wait until the tickets change, a countdown is due, the mode opens or closes, or the safety poll
+				wait := d.pollRate
+				if nextDue != nil && time.Until(*nextDue) < wait {
+					wait = time.Until(*nextDue)
+				}
+				if change := d.modeCfgController.GetConfig(config.Id).NextScheduleChange(time.Now(), wait); change != nil {
+					wait = time.Until(*change)
+				}
+
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-wake:
+				case <-timer.C:
+				}
+				timer.Stop()
 
-				// Wait for the next run
+				// Rate is the minimum time between runs so bursts of changes are handled together
 				timeSinceLastRun := time.Since(lastRunTime)
 				if timeSinceLastRun < config.MatchmakerInfo.Rate {
 					time.Sleep(config.MatchmakerInfo.Rate - timeSinceLastRun)
@@ -85,7 +118,8 @@ func (d *directorImpl) Start(ctx context.Context) {
 	}
 }
 
-func (d *directorImpl) run(ctx context.Context, originalConfig *liveconfig.GameModeConfig) {
+// run returns the time the next pending match of the mode is due to teleport, if there is one.
+func (d *directorImpl) run(ctx context.Context, originalConfig *liveconfig.GameModeConfig) *time.Time {
 	temp := *originalConfig
 	config := &temp
 
@@ -93,24 +127,33 @@ func (d *directorImpl) run(ctx context.Context, originalConfig *liveconfig.GameM
 	err := d.processDequeues(ctx, config)
 	if err != nil {
 		d.logger.Errorw("failed to process dequeues", "error", err)
-		return
+		return nil
+	}
+
+	// scheduled modes are only matched while open
+	if modeCfg := d.modeCfgController.GetConfig(config.Id); !modeCfg.IsOpen(time.Now()) {
+		if err := d.closeGameMode(ctx, config, modeCfg); err != nil {
+			d.logger.Errorw("failed to close game mode", "gamemode", config.Id, "error", err)
+		}
+		return nil
 	}
 
 	// run match function
-	matches, err := d.runMatchFunction(ctx, config)
+	matches, nextDue, err := d.runMatchFunction(ctx, config)
 	if err != nil {
 		d.logger.Errorw("failed to run match function", "error", err)
-		return
+		return nil
 	}
 
 	if len(matches) == 0 {
-		return
+		return nextDue
 	}
 
 	d.logger.Debugw("match function returned matches", "count", len(matches))
 	d.logger.Debugw("matches", "matches", matches)
 
 	// todo allocate gameservers
+	return nextDue
 }
 
 func (d *directorImpl) processDequeues(ctx context.Context, config *liveconfig.GameModeConfig) error {
@@ -194,11 +237,18 @@ func (d *directorImpl) processDequeues(ctx context.Context, config *liveconfig.G
 	return err
 }
 
-func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.GameModeConfig) ([]*pb.Match, error) {
+// runMatchFunction returns the created matches and, for countdown modes, the time the next pending match is due.
+func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.GameModeConfig) ([]*pb.Match, *time.Time, error) {
 	// NOTE: these tickets are ALL the tickets for this gamemode, even ones already in a PendingMatch
 	tickets, err := d.repo.GetTicketsByGameMode(ctx, cfg.Id)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// Spectator tickets don't take part in matchmaking, so they never count towards MaxPlayers
+	tickets, spectatorTickets := splitSpectatorTickets(tickets)
+	if len(spectatorTickets) > 0 {
+		d.assignSpectators(ctx, cfg, spectatorTickets)
 	}
 
 	if len(tickets) != 0 {
@@ -207,11 +257,12 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 
 	// make matches
 	var matches []*pb.Match
+	var nextDue *time.Time
 	switch cfg.MatchmakerInfo.MatchMethod {
 	case liveconfig.MatchMethodCountdown:
 		pendingMatches, err := d.repo.GetPendingMatchesByGameMode(ctx, cfg.Id)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		// Create a pending matches map
@@ -261,13 +312,13 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 				deletedIds = append(deletedIds, match.Id)
 			}
 			if err := d.repo.DeletePendingMatches(ctx, deletedIds); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 
 		createdPending, updatedPending, deletedPending, createdMatches, err := matchfunction2.RunCountdown(d.logger, ticketMap, pendingMatchesMap, cfg)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if len(createdPending) != 0 || len(updatedPending) != 0 || len(deletedPending) != 0 || len(createdMatches) != 0 {
@@ -282,11 +333,18 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 
 		matches = createdMatches // assign the created matches to the return value
 
+		// pendingMatchesMap now only contains the pending matches that are still counting down
+		for _, pending := range append(createdPending, maps.Values(pendingMatchesMap)...) {
+			if pending.TeleportTime != nil && (nextDue == nil || pending.TeleportTime.Before(*nextDue)) {
+				nextDue = pending.TeleportTime
+			}
+		}
+
 		// handle the pending match stuff
 		if len(updatedPending) > 0 {
 			err = d.repo.UpdatePendingMatches(ctx, updatedPending)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
 			for _, match := range updatedPending {
@@ -300,7 +358,7 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 		if len(createdPending) > 0 {
 			err = d.repo.CreatePendingMatches(ctx, createdPending)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
 			for _, match := range createdPending {
@@ -320,7 +378,7 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 
 			err = d.repo.DeletePendingMatches(ctx, deletedIds)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 
 			for _, match := range deletedPending {
@@ -339,7 +397,7 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 		if len(inPendingMatchUpdates) > 0 {
 			_, err = d.repo.MassUpdateTicketInPendingMatch(ctx, inPendingMatchUpdates)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 
@@ -355,7 +413,7 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 		matches, err = matchfunction2.RunInstant(tickets, cfg)
 	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(matches) != 0 {
@@ -363,13 +421,13 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 	}
 
 	if len(matches) == 0 {
-		return nil, nil
+		return nil, nextDue, nil
 	}
 
 	if len(cfg.Maps) > 0 {
 		err = d.calculateMaps(ctx, cfg, matches)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -389,6 +447,9 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 		}
 	}
 
+	// Keep a record of the matches so players can rejoin or spectate them
+	d.saveMatchRecords(ctx, matches)
+
 	// delete all Tickets and QueuedPlayers in Matches (not PendingMatches)
 	for _, match := range matches {
 		ticketIds := make([]primitive.ObjectID, 0)
@@ -416,7 +477,7 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 		// Delete Tickets
 		deletedCount, err := d.repo.DeleteAllTicketsById(ctx, ticketIds)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if int(deletedCount) != len(ticketIds) {
@@ -426,7 +487,7 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 		// Delete QueuedPlayers
 		deletedCount, err = d.repo.DeleteAllQueuedPlayersById(ctx, playerIds)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		if int(deletedCount) != len(playerIds) {
@@ -434,7 +495,7 @@ func (d *directorImpl) runMatchFunction(ctx context.Context, cfg *liveconfig.Gam
 		}
 	}
 
-	return matches, nil
+	return matches, nextDue, nil
 }
 
 // calculate map retrieves the map votes for those present in a Match
@@ -0,0 +1,32 @@
+package director
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository/model"
+	pb "github.com/emortalmc/proto-specs/gen/go/model/matchmaker"
+)
+
+// saveMatchRecords stores a record of every allocated match. A failure here only stops rejoining so it isn't returned.
+func (d *directorImpl) saveMatchRecords(ctx context.Context, matches []*pb.Match) {
+	records := make([]*model.MatchRecord, 0, len(matches))
+	for _, match := range matches {
+		if match.Assignment == nil {
+			continue
+		}
+
+		record, err := model.NewMatchRecord(match)
+		if err != nil {
+			d.logger.Errorw("failed to create match record", "match", match.Id, "error", err)
+			continue
+		}
+		records = append(records, record)
+	}
+
+	if len(records) == 0 {
+		return
+	}
+
+	if err := d.repo.CreateMatchRecords(ctx, records); err != nil {
+		d.logger.Errorw("failed to save match records", "count", len(records), "error", err)
+	}
+}
@@ -0,0 +1,83 @@
+package director
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/live-config-parser/golang/pkg/liveconfig"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/modeconfig"
+	msg "github.com/emortalmc/proto-specs/gen/go/message/matchmaker"
+	pb "github.com/emortalmc/proto-specs/gen/go/model/matchmaker"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// closeGameMode dequeues every ticket of a mode that is closed by its schedule.
+// Pending matches are cancelled as they can never start.
+func (d *directorImpl) closeGameMode(ctx context.Context, config *liveconfig.GameModeConfig, modeCfg *modeconfig.ModeConfig) error {
+	tickets, err := d.repo.GetTicketsByGameMode(ctx, config.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get tickets: %w", err)
+	}
+
+	if len(tickets) == 0 {
+		return nil
+	}
+
+	pendingMatches, err := d.repo.GetPendingMatchesByGameMode(ctx, config.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get pending matches: %w", err)
+	}
+
+	if len(pendingMatches) > 0 {
+		pendingIds := make([]primitive.ObjectID, len(pendingMatches))
+		for i, match := range pendingMatches {
+			pendingIds[i] = match.Id
+		}
+
+		if err := d.repo.DeletePendingMatches(ctx, pendingIds); err != nil {
+			return fmt.Errorf("failed to delete pending matches: %w", err)
+		}
+
+		for _, match := range pendingMatches {
+			if err := d.notifier.PendingMatchDeleted(ctx, match, msg.PendingMatchDeletedMessage_CANCELLED); err != nil {
+				d.logger.Errorw("failed to send pending match deleted notification", "error", err)
+			}
+		}
+	}
+
+	ticketIds := make([]primitive.ObjectID, 0, len(tickets))
+	playerIds := make([]uuid.UUID, 0)
+	pbTickets := make([]*pb.Ticket, 0, len(tickets))
+	for _, ticket := range tickets {
+		ticketIds = append(ticketIds, ticket.Id)
+		playerIds = append(playerIds, ticket.PlayerIds...)
+		pbTickets = append(pbTickets, ticket.ToProto())
+	}
+
+	if _, err := d.repo.DeleteAllTicketsById(ctx, ticketIds); err != nil {
+		return fmt.Errorf("failed to delete tickets: %w", err)
+	}
+
+	if _, err := d.repo.DeleteAllQueuedPlayersById(ctx, playerIds); err != nil {
+		return fmt.Errorf("failed to delete players: %w", err)
+	}
+
+	for _, ticket := range pbTickets {
+		if err := d.notifier.TicketDeleted(ctx, ticket, msg.TicketDeletedMessage_GAME_MODE_DELETED); err != nil {
+			d.logger.Errorw("failed to send ticket deleted notification", "error", err)
+		}
+	}
+
+	var nextOpenTime *time.Time
+	if nextOpen, ok := modeCfg.NextOpen(time.Now(), modeconfig.NextOpenLookahead); ok {
+		nextOpenTime = &nextOpen
+	}
+
+	if err := d.notifier.GameModeClosed(ctx, config.Id, pbTickets, nextOpenTime); err != nil {
+		d.logger.Errorw("failed to send game mode closed notification", "error", err)
+	}
+
+	d.logger.Infow("closed scheduled game mode", "gamemode", config.Id, "tickets", len(tickets), "nextOpenTime", nextOpenTime)
+	return nil
+}
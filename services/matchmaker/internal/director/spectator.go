@@ -0,0 +1,106 @@
+package director
+
+import (
+	"context"
+	"errors"
+	"github.com/emortalmc/live-config-parser/golang/pkg/liveconfig"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/gsallocation"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/gsallocation/selector"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository/model"
+	msg "github.com/emortalmc/proto-specs/gen/go/message/matchmaker"
+	pb "github.com/emortalmc/proto-specs/gen/go/model/matchmaker"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func splitSpectatorTickets(tickets []*model.Ticket) (players []*model.Ticket, spectators []*model.Ticket) {
+	for _, ticket := range tickets {
+		if ticket.Spectate != nil {
+			spectators = append(spectators, ticket)
+		} else {
+			players = append(players, ticket)
+		}
+	}
+
+	return players, spectators
+}
+
+// assignSpectators sends each spectator ticket to the server of the match it targets.
+// Tickets that fail to allocate (e.g. the server is briefly unavailable) are kept and retried on the next run.
+// Tickets whose match has finished are deleted.
+func (d *directorImpl) assignSpectators(ctx context.Context, cfg *liveconfig.GameModeConfig, tickets []*model.Ticket) {
+	for _, ticket := range tickets {
+		// Leave tickets that are being dequeued to processDequeues
+		if ticket.Removals != nil {
+			continue
+		}
+
+		record, err := d.repo.GetMatchRecordByMatchId(ctx, ticket.Spectate.MatchId)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				// The match has finished, there's nothing left to spectate
+				d.deleteFinishedSpectatorTicket(ctx, ticket)
+				continue
+			}
+
+			d.logger.Errorw("failed to get match record", "match", ticket.Spectate.MatchId, "error", err)
+			continue
+		}
+
+		pbTicket := ticket.ToProto()
+		match := &pb.Match{
+			Id:         record.MatchId,
+			GameModeId: cfg.Id,
+			MapId:      record.MapId,
+			Tickets:    []*pb.Ticket{pbTicket},
+		}
+
+		allocation := selector.CreateSpectatorSelector(cfg.FleetName, record.MatchId, pbTicket.PlayerIds)
+		if err := gsallocation.AllocateServer(ctx, d.allocationClient, match, allocation); err != nil {
+			d.logger.Warnw("failed to allocate spectator", "match", record.MatchId, "ticket", ticket.Id.Hex(), "error", err)
+			continue
+		}
+
+		if err := d.notifier.MatchCreated(ctx, match); err != nil {
+			d.logger.Errorw("error notifying of spectator match creation", "match", match.Id, "error", err)
+		}
+
+		d.deleteSpectatorTicket(ctx, ticket, msg.TicketDeletedMessage_MATCH_CREATED)
+	}
+}
+
+func (d *directorImpl) deleteSpectatorTicket(ctx context.Context, ticket *model.Ticket, reason msg.TicketDeletedMessage_Reason) {
+	if !d.removeSpectatorTicket(ctx, ticket) {
+		return
+	}
+
+	if err := d.notifier.TicketDeleted(ctx, ticket.ToProto(), reason); err != nil {
+		d.logger.Errorw("failed to send ticket deleted notification", "error", err)
+	}
+}
+
+func (d *directorImpl) deleteFinishedSpectatorTicket(ctx context.Context, ticket *model.Ticket) {
+	if !d.removeSpectatorTicket(ctx, ticket) {
+		return
+	}
+
+	err := d.notifier.SpectatorTicketDeleted(ctx, ticket.ToProto(), matchmakerpb.SpectatorTicketDeletedMessage_MATCH_FINISHED)
+	if err != nil {
+		d.logger.Errorw("failed to send spectator ticket deleted notification", "error", err)
+	}
+}
+
+// removeSpectatorTicket returns false if the ticket couldn't be deleted
+func (d *directorImpl) removeSpectatorTicket(ctx context.Context, ticket *model.Ticket) bool {
+	if _, err := d.repo.DeleteAllTicketsById(ctx, []primitive.ObjectID{ticket.Id}); err != nil {
+		d.logger.Errorw("failed to delete spectator ticket", "ticket", ticket.Id.Hex(), "error", err)
+		return false
+	}
+
+	if _, err := d.repo.DeleteAllQueuedPlayersById(ctx, ticket.PlayerIds); err != nil {
+		d.logger.Errorw("failed to delete spectator queued player", "ticket", ticket.Id.Hex(), "error", err)
+	}
+
+	return true
+}
@@ -0,0 +1,206 @@
+package eligibility
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/modeconfig"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/utils"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/utils/experience"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/mcplayer"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/permission"
+	permModel "github.com/emortalmc/proto-specs/gen/go/model/permission"
+	"github.com/google/uuid"
+	"slices"
+	"sort"
+)
+
+// defaultRoleId is the role every player has. permission-service stores it against players, but it is added
+// in case a player's roles were never initialised.
+const defaultRoleId = "default"
+
+type Checker interface {
+	// Check returns the first rule the party fails or nil if the whole party may queue.
+	Check(ctx context.Context, rules *modeconfig.QueueRules, leaderId uuid.UUID, memberIds []uuid.UUID) (*matchmakerpb.QueueEligibilityErrorResponse, error)
+}
+
+type checkerImpl struct {
+	permissionService permission.PermissionServiceClient
+	mcPlayerService   mcplayer.McPlayerClient
+}
+
+func NewChecker(permissionService permission.PermissionServiceClient, mcPlayerService mcplayer.McPlayerClient) Checker {
+	return &checkerImpl{
+		permissionService: permissionService,
+		mcPlayerService:   mcPlayerService,
+	}
+}
+
+func (c *checkerImpl) Check(ctx context.Context, rules *modeconfig.QueueRules, leaderId uuid.UUID,
+	memberIds []uuid.UUID) (*matchmakerpb.QueueEligibilityErrorResponse, error) {
+
+	if rules == nil {
+		return nil, nil
+	}
+
+	// Cheapest first, only the leader's roles are needed.
+	if len(rules.MaxPartySizes) > 0 {
+		failure, err := c.checkPartySize(ctx, rules.MaxPartySizes, leaderId, len(memberIds))
+		if err != nil || failure != nil {
+			return failure, err
+		}
+	}
+
+	if rules.Permission != nil {
+		failure, err := c.checkPermission(ctx, *rules.Permission, memberIds)
+		if err != nil || failure != nil {
+			return failure, err
+		}
+	}
+
+	if rules.MinLevel != nil {
+		failure, err := c.checkLevel(ctx, *rules.MinLevel, memberIds)
+		if err != nil || failure != nil {
+			return failure, err
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *checkerImpl) checkPartySize(ctx context.Context, maxSizes map[string]int, leaderId uuid.UUID,
+	partySize int) (*matchmakerpb.QueueEligibilityErrorResponse, error) {
+
+	roleIds, err := c.getPlayerRoleIds(ctx, leaderId)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := -1
+	for _, roleId := range roleIds {
+		if size, ok := maxSizes[roleId]; ok && size > limit {
+			limit = size
+		}
+	}
+
+	if limit == -1 || partySize <= limit {
+		return nil, nil
+	}
+
+	return &matchmakerpb.QueueEligibilityErrorResponse{
+		Reason:       matchmakerpb.QueueEligibilityErrorResponse_PARTY_TOO_LARGE_FOR_ROLE,
+		PlayerIds:    []string{leaderId.String()},
+		MaxPartySize: utils.PointerOf(uint32(limit)),
+	}, nil
+}
+
+func (c *checkerImpl) checkPermission(ctx context.Context, node string,
+	memberIds []uuid.UUID) (*matchmakerpb.QueueEligibilityErrorResponse, error) {
+
+	rolesRes, err := c.permissionService.GetAllRoles(ctx, &permission.GetAllRolesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+
+	roles := make(map[string]*permModel.Role)
+	for _, role := range rolesRes.Roles {
+		roles[role.Id] = role
+	}
+
+	failed := make([]string, 0)
+	for _, memberId := range memberIds {
+		roleIds, err := c.getPlayerRoleIds(ctx, memberId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !hasPermission(roles, roleIds, node) {
+			failed = append(failed, memberId.String())
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil, nil
+	}
+
+	return &matchmakerpb.QueueEligibilityErrorResponse{
+		Reason:             matchmakerpb.QueueEligibilityErrorResponse_MISSING_PERMISSION,
+		PlayerIds:          failed,
+		RequiredPermission: &node,
+	}, nil
+}
+
+func (c *checkerImpl) checkLevel(ctx context.Context, minLevel int,
+	memberIds []uuid.UUID) (*matchmakerpb.QueueEligibilityErrorResponse, error) {
+
+	ids := make([]string, len(memberIds))
+	for i, id := range memberIds {
+		ids[i] = id.String()
+	}
+
+	res, err := c.mcPlayerService.GetPlayers(ctx, &mcplayer.GetPlayersRequest{PlayerIds: ids})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+
+	levels := make(map[string]int)
+	for _, player := range res.Players {
+		levels[player.Id] = experience.XPToLevel(int(player.Experience))
+	}
+
+	failed := make([]string, 0)
+	for _, id := range ids {
+		// A player the mc-player-service doesn't know about has no experience.
+		if levels[id] < minLevel {
+			failed = append(failed, id)
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil, nil
+	}
+
+	return &matchmakerpb.QueueEligibilityErrorResponse{
+		Reason:        matchmakerpb.QueueEligibilityErrorResponse_LEVEL_TOO_LOW,
+		PlayerIds:     failed,
+		RequiredLevel: utils.PointerOf(uint32(minLevel)),
+	}, nil
+}
+
+func (c *checkerImpl) getPlayerRoleIds(ctx context.Context, playerId uuid.UUID) ([]string, error) {
+	res, err := c.permissionService.GetPlayerRoles(ctx, &permission.GetPlayerRolesRequest{PlayerId: playerId.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player roles (playerId: %s): %w", playerId, err)
+	}
+
+	if slices.Contains(res.RoleIds, defaultRoleId) {
+		return res.RoleIds, nil
+	}
+
+	return append(res.RoleIds, defaultRoleId), nil
+}
+
+// hasPermission resolves the node by the highest priority role that sets it.
+// If no role sets it, the player doesn't have it.
+func hasPermission(roles map[string]*permModel.Role, roleIds []string, node string) bool {
+	playerRoles := make([]*permModel.Role, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		if role, ok := roles[roleId]; ok {
+			playerRoles = append(playerRoles, role)
+		}
+	}
+
+	sort.Slice(playerRoles, func(i, j int) bool {
+		return playerRoles[i].Priority > playerRoles[j].Priority
+	})
+
+	for _, role := range playerRoles {
+		for _, perm := range role.Permissions {
+			if perm.Node == node {
+				return perm.State == permModel.PermissionNode_ALLOW
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,197 @@
+package eligibility
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/modeconfig"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/utils"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/mcplayer"
+	"github.com/emortalmc/proto-specs/gen/go/grpc/permission"
+	mcPlayerModel "github.com/emortalmc/proto-specs/gen/go/model/mcplayer"
+	permModel "github.com/emortalmc/proto-specs/gen/go/model/permission"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+const playNode = "matchmaker.play.ranked"
+
+var roles = []*permModel.Role{
+	{Id: "default", Priority: 0},
+	{Id: "vip", Priority: 10, Permissions: []*permModel.PermissionNode{{Node: playNode, State: permModel.PermissionNode_ALLOW}}},
+	{Id: "banned", Priority: 20, Permissions: []*permModel.PermissionNode{{Node: playNode, State: permModel.PermissionNode_DENY}}},
+	{Id: "staff", Priority: 30, Permissions: []*permModel.PermissionNode{{Node: playNode, State: permModel.PermissionNode_ALLOW}}},
+}
+
+type fakePermissionService struct {
+	permission.PermissionServiceClient
+
+	playerRoles map[string][]string
+}
+
+func (s *fakePermissionService) GetAllRoles(_ context.Context, _ *permission.GetAllRolesRequest,
+	_ ...grpc.CallOption) (*permission.GetAllRolesResponse, error) {
+
+	return &permission.GetAllRolesResponse{Roles: roles}, nil
+}
+
+func (s *fakePermissionService) GetPlayerRoles(_ context.Context, in *permission.GetPlayerRolesRequest,
+	_ ...grpc.CallOption) (*permission.PlayerRolesResponse, error) {
+
+	return &permission.PlayerRolesResponse{RoleIds: s.playerRoles[in.PlayerId]}, nil
+}
+
+type fakeMcPlayerService struct {
+	mcplayer.McPlayerClient
+
+	experience map[string]uint64
+}
+
+func (s *fakeMcPlayerService) GetPlayers(_ context.Context, in *mcplayer.GetPlayersRequest,
+	_ ...grpc.CallOption) (*mcplayer.GetPlayersResponse, error) {
+
+	players := make([]*mcPlayerModel.McPlayer, 0)
+	for _, id := range in.PlayerIds {
+		if xp, ok := s.experience[id]; ok {
+			players = append(players, &mcPlayerModel.McPlayer{Id: id, Experience: xp})
+		}
+	}
+
+	return &mcplayer.GetPlayersResponse{Players: players}, nil
+}
+
+func TestHasPermission(t *testing.T) {
+	roleMap := make(map[string]*permModel.Role)
+	for _, role := range roles {
+		roleMap[role.Id] = role
+	}
+
+	tests := []struct {
+		name    string
+		roleIds []string
+		want    bool
+	}{
+		{
+			name:    "no role sets the node",
+			roleIds: []string{"default"},
+			want:    false,
+		},
+		{
+			name:    "role allows the node",
+			roleIds: []string{"default", "vip"},
+			want:    true,
+		},
+		{
+			name:    "higher priority deny wins",
+			roleIds: []string{"vip", "banned"},
+			want:    false,
+		},
+		{
+			name:    "higher priority allow wins",
+			roleIds: []string{"staff", "banned"},
+			want:    true,
+		},
+		{
+			name:    "unknown roles are ignored",
+			roleIds: []string{"unknown", "vip"},
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hasPermission(roleMap, tt.roleIds, playNode))
+		})
+	}
+}
+
+func TestChecker_Check(t *testing.T) {
+	leaderId := uuid.New()
+	memberId := uuid.New()
+	otherId := uuid.New()
+
+	tests := []struct {
+		name        string
+		rules       *modeconfig.QueueRules
+		memberIds   []uuid.UUID
+		playerRoles map[string][]string
+		experience  map[string]uint64
+
+		want *matchmakerpb.QueueEligibilityErrorResponse
+	}{
+		{
+			name:      "no rules",
+			memberIds: []uuid.UUID{leaderId, memberId},
+		},
+		{
+			name:        "party too large for leader's roles",
+			rules:       &modeconfig.QueueRules{MaxPartySizes: map[string]int{"default": 1, "vip": 2}},
+			memberIds:   []uuid.UUID{leaderId, memberId, otherId},
+			playerRoles: map[string][]string{leaderId.String(): {"vip"}},
+			want: &matchmakerpb.QueueEligibilityErrorResponse{
+				Reason:       matchmakerpb.QueueEligibilityErrorResponse_PARTY_TOO_LARGE_FOR_ROLE,
+				PlayerIds:    []string{leaderId.String()},
+				MaxPartySize: utils.PointerOf(uint32(2)),
+			},
+		},
+		{
+			name:        "leader's highest limit applies",
+			rules:       &modeconfig.QueueRules{MaxPartySizes: map[string]int{"default": 1, "vip": 3}},
+			memberIds:   []uuid.UUID{leaderId, memberId, otherId},
+			playerRoles: map[string][]string{leaderId.String(): {"vip"}},
+		},
+		{
+			name:      "leader has none of the limited roles",
+			rules:     &modeconfig.QueueRules{MaxPartySizes: map[string]int{"vip": 1}},
+			memberIds: []uuid.UUID{leaderId, memberId},
+		},
+		{
+			name:      "members missing permission",
+			rules:     &modeconfig.QueueRules{Permission: utils.PointerOf(playNode)},
+			memberIds: []uuid.UUID{leaderId, memberId, otherId},
+			playerRoles: map[string][]string{
+				leaderId.String(): {"vip"},
+				memberId.String(): {"vip", "banned"},
+			},
+			want: &matchmakerpb.QueueEligibilityErrorResponse{
+				Reason:             matchmakerpb.QueueEligibilityErrorResponse_MISSING_PERMISSION,
+				PlayerIds:          []string{memberId.String(), otherId.String()},
+				RequiredPermission: utils.PointerOf(playNode),
+			},
+		},
+		{
+			name:       "unknown player has no level",
+			rules:      &modeconfig.QueueRules{MinLevel: utils.PointerOf(10)},
+			memberIds:  []uuid.UUID{leaderId, memberId},
+			experience: map[string]uint64{leaderId.String(): 10_000}, // Level 15
+			want: &matchmakerpb.QueueEligibilityErrorResponse{
+				Reason:        matchmakerpb.QueueEligibilityErrorResponse_LEVEL_TOO_LOW,
+				PlayerIds:     []string{memberId.String()},
+				RequiredLevel: utils.PointerOf(uint32(10)),
+			},
+		},
+		{
+			name:      "party size is checked first",
+			rules:     &modeconfig.QueueRules{MaxPartySizes: map[string]int{"default": 1}, Permission: utils.PointerOf(playNode)},
+			memberIds: []uuid.UUID{leaderId, memberId},
+			want: &matchmakerpb.QueueEligibilityErrorResponse{
+				Reason:       matchmakerpb.QueueEligibilityErrorResponse_PARTY_TOO_LARGE_FOR_ROLE,
+				PlayerIds:    []string{leaderId.String()},
+				MaxPartySize: utils.PointerOf(uint32(1)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker(&fakePermissionService{playerRoles: tt.playerRoles},
+				&fakeMcPlayerService{experience: tt.experience})
+
+			got, err := checker.Check(context.Background(), tt.rules, leaderId, tt.memberIds)
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(tt.want, got), "got %v, want %v", got, tt.want)
+		})
+	}
+}
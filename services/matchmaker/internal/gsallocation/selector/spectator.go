@@ -0,0 +1,44 @@
+package selector
+
+import (
+	allocatorv1 "agones.dev/agones/pkg/apis/allocation/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SpectatorAnnotationPrefix is followed by the player id. The value is the id of the match they're spectating.
+// Game servers use it to put the player in spectator mode when they join.
+const SpectatorAnnotationPrefix = "emortal.dev/spectator-"
+
+// CreateSpectatorSelector selects the GameServer running the match.
+// Spectators don't take up player slots, so a full match can still be spectated.
+// Only works for matches allocated with CreateAvailableSelector as the match id has to be in the 'games' list.
+// The annotations are removed with gsallocation.RemoveSpectatorAnnotations when the match ends.
+func CreateSpectatorSelector(fleetName string, matchId string, spectatorIds []string) *allocatorv1.GameServerAllocation {
+	annotations := make(map[string]string, len(spectatorIds))
+	for _, id := range spectatorIds {
+		annotations[SpectatorAnnotationPrefix+id] = matchId
+	}
+
+	return &allocatorv1.GameServerAllocation{
+		Spec: allocatorv1.GameServerAllocationSpec{
+			Selectors: []allocatorv1.GameServerSelector{
+				{
+					LabelSelector: v1.LabelSelector{
+						MatchLabels: map[string]string{
+							"agones.dev/fleet": fleetName,
+						},
+					},
+					Lists: map[string]allocatorv1.ListSelector{
+						"games": {
+							ContainsValue: matchId,
+						},
+					},
+					GameServerState: &AllocatedState,
+				},
+			},
+			MetaPatch: allocatorv1.MetaPatch{
+				Annotations: annotations,
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package gsallocation
+
+import (
+	agonesv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/gsallocation/selector"
+	"k8s.io/apimachinery/pkg/api/errors"
+	kubev1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"slices"
+	"strings"
+)
+
+// RemoveSpectatorAnnotations removes the spectator annotations of the matches from the GameServer.
+// Nothing is done if the GameServer no longer exists.
+func RemoveSpectatorAnnotations(ctx context.Context, gameServers agonesv1.GameServerInterface, serverId string, matchIds []string) error {
+	gameServer, err := gameServers.Get(ctx, serverId, kubev1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get game server: %w", err)
+	}
+
+	annotations := make(map[string]any)
+	for key, value := range gameServer.Annotations {
+		if strings.HasPrefix(key, selector.SpectatorAnnotationPrefix) && slices.Contains(matchIds, value) {
+			annotations[key] = nil // null removes the annotation in a merge patch
+		}
+	}
+
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": annotations}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+
+	if _, err := gameServers.Patch(ctx, serverId, types.MergePatchType, patch, kubev1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to patch game server: %w", err)
+	}
+
+	return nil
+}
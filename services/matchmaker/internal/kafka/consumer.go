@@ -1,11 +1,17 @@
 package kafka
 
 import (
+	agonesv1 "agones.dev/agones/pkg/client/clientset/versioned/typed/agones/v1"
 	"context"
 	"fmt"
+	"github.com/emortalmc/mono-services/libraries/gametracker/gen/go/gametrackermsgpb"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/config"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/gsallocation"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/trigger"
+	"github.com/emortalmc/proto-specs/gen/go/message/gametracker"
 	"github.com/emortalmc/proto-specs/gen/go/message/party"
+	pbgametracker "github.com/emortalmc/proto-specs/gen/go/model/gametracker"
 	"github.com/emortalmc/proto-specs/gen/go/nongenerated/kafkautils"
 	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
@@ -18,19 +24,29 @@ import (
 // NOTE: We don't listen to player connections as player disconnect = party leave or disband - so it's handled by that.
 const partyTopic = "party-manager"
 
+// gameTrackerTopic is used to find out when a game finishes so players can no longer rejoin it.
+const gameTrackerTopic = "game-tracker"
+
+// gameTrackerEventsTopic is used to find out when game-tracker abandons a game that never sent a finish message,
+// e.g. because its server crashed.
+const gameTrackerEventsTopic = "game-tracker-events"
+
 type consumer struct {
 	logger *zap.SugaredLogger
 
 	reader *kafka.Reader
 
-	repo repository.Repository
+	repo        repository.Repository
+	trigger     trigger.Trigger
+	gameServers agonesv1.GameServerInterface
 }
 
-func NewConsumer(ctx context.Context, wg *sync.WaitGroup, config config.KafkaConfig, logger *zap.SugaredLogger, repo repository.Repository) {
+func NewConsumer(ctx context.Context, wg *sync.WaitGroup, config config.KafkaConfig, logger *zap.SugaredLogger, repo repository.Repository,
+	trigger trigger.Trigger, gameServers agonesv1.GameServerInterface) {
 	reader := kafka.NewReader(kafka.ReaderConfig{
 		Brokers:     []string{fmt.Sprintf("%s:%d", config.Host, config.Port)},
 		GroupID:     "matchmaker",
-		GroupTopics: []string{partyTopic},
+		GroupTopics: []string{partyTopic, gameTrackerTopic, gameTrackerEventsTopic},
 
 		Logger: kafka.LoggerFunc(func(format string, args ...interface{}) {
 			logger.Infow(fmt.Sprintf(format, args...))
@@ -41,15 +57,19 @@ func NewConsumer(ctx context.Context, wg *sync.WaitGroup, config config.KafkaCon
 	})
 
 	c := &consumer{
-		logger: logger,
-		reader: reader,
-		repo:   repo,
+		logger:      logger,
+		reader:      reader,
+		repo:        repo,
+		trigger:     trigger,
+		gameServers: gameServers,
 	}
 
 	handler := kafkautils.NewConsumerHandler(logger, reader)
 	handler.RegisterHandler(&party.PartyDeletedMessage{}, c.handlePartyDisband)
 	handler.RegisterHandler(&party.PartyPlayerJoinedMessage{}, c.handlePartyPlayerJoined)
 	handler.RegisterHandler(&party.PartyPlayerLeftMessage{}, c.handlePartyPlayerLeft)
+	handler.RegisterHandler(&gametracker.GameFinishMessage{}, c.handleGameFinish)
+	handler.RegisterHandler(&gametrackermsgpb.GameAbandonedMessage{}, c.handleGameAbandoned)
 
 	logger.Infow("starting listening for kafka messages", "topics", reader.Config().GroupTopics)
 
@@ -78,6 +98,9 @@ func (c *consumer) handlePartyDisband(ctx context.Context, _ *kafka.Message, unc
 		c.logger.Errorw("failed to add ticket dequeue request", err)
 		return
 	}
+
+	// We don't know the game mode of the party's ticket
+	c.trigger.NotifyAll()
 }
 
 // TODO we don't account for if a ticket size increases and there isn't enough space in their existing PendingMatch
@@ -106,4 +129,49 @@ func (c *consumer) handlePartyPlayerLeft(ctx context.Context, _ *kafka.Message,
 		c.logger.Errorw("failed to add player dequeue request", err)
 		return
 	}
+
+	c.trigger.NotifyAll()
+}
+
+func (c *consumer) handleGameFinish(ctx context.Context, _ *kafka.Message, uncast proto.Message) {
+	pMsg := uncast.(*gametracker.GameFinishMessage)
+
+	c.deleteMatchRecords(ctx, pMsg.CommonData.ServerId, pMsg.CommonData.Players)
+}
+
+func (c *consumer) handleGameAbandoned(ctx context.Context, _ *kafka.Message, uncast proto.Message) {
+	pMsg := uncast.(*gametrackermsgpb.GameAbandonedMessage)
+
+	c.deleteMatchRecords(ctx, pMsg.ServerId, pMsg.Players)
+}
+
+// deleteMatchRecords stops the players from rejoining or spectating the game that ended on the server
+func (c *consumer) deleteMatchRecords(ctx context.Context, serverId string, players []*pbgametracker.BasicGamePlayer) {
+	playerIds := make([]uuid.UUID, 0, len(players))
+	for _, player := range players {
+		playerId, err := uuid.Parse(player.Id)
+		if err != nil {
+			c.logger.Errorw("failed to parse player id", "playerId", player.Id, "error", err)
+			continue
+		}
+		playerIds = append(playerIds, playerId)
+	}
+
+	if len(playerIds) == 0 {
+		return
+	}
+
+	matchIds, err := c.repo.DeleteMatchRecordsByServer(ctx, serverId, playerIds)
+	if err != nil {
+		c.logger.Errorw("failed to delete match records", "serverId", serverId, "error", err)
+		return
+	}
+
+	if len(matchIds) == 0 {
+		return
+	}
+
+	if err := gsallocation.RemoveSpectatorAnnotations(ctx, c.gameServers, serverId, matchIds); err != nil {
+		c.logger.Errorw("failed to remove spectator annotations", "serverId", serverId, "error", err)
+	}
 }
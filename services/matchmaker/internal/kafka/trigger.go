@@ -0,0 +1,143 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/config"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/trigger"
+	"github.com/emortalmc/proto-specs/gen/go/nongenerated/kafkautils"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"sync"
+	"time"
+)
+
+// directorTriggerTopic must have a single partition, as every replica reads all of it without a consumer group
+const directorTriggerTopic = "matchmaker-director-trigger"
+
+// distributedTrigger wakes the local loops straight away and the loops of other replicas through Kafka,
+// as tickets can be changed by a request to any replica.
+type distributedTrigger struct {
+	trigger.Trigger
+
+	logger   *zap.SugaredLogger
+	w        *kafka.Writer
+	senderId string
+}
+
+// NewDistributedTrigger returns an error if the trigger topic doesn't have a single partition, as triggers sent to
+// the other partitions would never be read.
+func NewDistributedTrigger(ctx context.Context, wg *sync.WaitGroup, cfg config.KafkaConfig, logger *zap.SugaredLogger,
+	local trigger.Trigger) (trigger.Trigger, error) {
+
+	if err := checkTriggerPartitions(ctx, cfg); err != nil {
+		return nil, err
+	}
+
+	t := &distributedTrigger{
+		Trigger: local,
+		logger:  logger,
+		w: &kafka.Writer{
+			Addr:         kafka.TCP(fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)),
+			Topic:        directorTriggerTopic,
+			Async:        true,
+			BatchTimeout: 10 * time.Millisecond,
+			ErrorLogger:  kafka.LoggerFunc(logger.Errorw),
+		},
+		senderId: uuid.NewString(),
+	}
+
+	// Triggers sent before this replica started are irrelevant, the loops run once on start anyway
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     []string{fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)},
+		Topic:       directorTriggerTopic,
+		StartOffset: kafka.LastOffset,
+
+		ErrorLogger: kafka.LoggerFunc(func(format string, args ...interface{}) {
+			logger.Errorw(fmt.Sprintf(format, args...))
+		}),
+	})
+
+	handler := kafkautils.NewConsumerHandler(logger, reader)
+	handler.RegisterHandler(&matchmakerpb.DirectorTriggerMessage{}, t.handleTrigger)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		handler.Run(ctx) // Run is blocking until the context is cancelled
+		if err := reader.Close(); err != nil {
+			logger.Errorw("error closing kafka reader", "error", err)
+		}
+		if err := t.w.Close(); err != nil {
+			logger.Errorw("error closing kafka writer", "error", err)
+		}
+	}()
+
+	return t, nil
+}
+
+func checkTriggerPartitions(ctx context.Context, cfg config.KafkaConfig) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	conn, err := kafka.DialContext(ctx, "tcp", fmt.Sprintf("%s:%d", cfg.Host, cfg.Port))
+	if err != nil {
+		return fmt.Errorf("failed to connect to kafka: %w", err)
+	}
+	defer conn.Close()
+
+	partitions, err := conn.ReadPartitions(directorTriggerTopic)
+	if err != nil {
+		return fmt.Errorf("failed to read partitions of %s: %w", directorTriggerTopic, err)
+	}
+
+	if len(partitions) != 1 {
+		return fmt.Errorf("%s must have a single partition but has %d", directorTriggerTopic, len(partitions))
+	}
+
+	return nil
+}
+
+func (t *distributedTrigger) Notify(gameModeId string) {
+	t.Trigger.Notify(gameModeId)
+	t.publish(&gameModeId)
+}
+
+func (t *distributedTrigger) NotifyAll() {
+	t.Trigger.NotifyAll()
+	t.publish(nil)
+}
+
+// publish never blocks as the writer is async. A lost trigger is covered by the director's poll.
+func (t *distributedTrigger) publish(gameModeId *string) {
+	pMsg := &matchmakerpb.DirectorTriggerMessage{GameModeId: gameModeId, SenderId: t.senderId}
+	bytes, err := proto.Marshal(pMsg)
+	if err != nil {
+		t.logger.Errorw("failed to marshal director trigger", "error", err)
+		return
+	}
+
+	err = t.w.WriteMessages(context.Background(), kafka.Message{
+		Headers: []kafka.Header{{Key: "X-Proto-Type", Value: []byte(pMsg.ProtoReflect().Descriptor().FullName())}},
+		Value:   bytes,
+	})
+	if err != nil {
+		t.logger.Errorw("failed to send director trigger", "error", err)
+	}
+}
+
+func (t *distributedTrigger) handleTrigger(_ context.Context, _ *kafka.Message, uncast proto.Message) {
+	pMsg := uncast.(*matchmakerpb.DirectorTriggerMessage)
+	if pMsg.SenderId == t.senderId {
+		return
+	}
+
+	if pMsg.GameModeId == nil {
+		t.Trigger.NotifyAll()
+	} else {
+		t.Trigger.Notify(*pMsg.GameModeId)
+	}
+}
@@ -3,6 +3,7 @@ package kafka
 import (
 	"context"
 	"fmt"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/config"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository/model"
 	msg "github.com/emortalmc/proto-specs/gen/go/message/matchmaker"
@@ -10,6 +11,7 @@ import (
 	"github.com/segmentio/kafka-go"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"sync"
 	"time"
 )
@@ -27,6 +29,12 @@ type Notifier interface {
 	PendingMatchDeleted(ctx context.Context, match *model.PendingMatch, reason msg.PendingMatchDeletedMessage_Reason) error
 
 	MatchCreated(ctx context.Context, match *pb.Match) error
+	// MatchRejoined match should have no tickets
+	MatchRejoined(ctx context.Context, playerId string, match *pb.Match) error
+	SpectatorTicketDeleted(ctx context.Context, ticket *pb.Ticket, reason matchmakerpb.SpectatorTicketDeletedMessage_Reason) error
+
+	// GameModeClosed nextOpenTime may be nil if the mode doesn't open again soon.
+	GameModeClosed(ctx context.Context, gameModeId string, tickets []*pb.Ticket, nextOpenTime *time.Time) error
 }
 
 type kafkaNotifier struct {
@@ -180,3 +188,61 @@ func (k *kafkaNotifier) MatchCreated(ctx context.Context, match *pb.Match) error
 
 	return err
 }
+
+func (k *kafkaNotifier) MatchRejoined(ctx context.Context, playerId string, match *pb.Match) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pMsg := &matchmakerpb.MatchRejoinedMessage{PlayerId: playerId, Match: match}
+	bytes, err := proto.Marshal(pMsg)
+	if err != nil {
+		return err
+	}
+
+	err = k.w.WriteMessages(ctx, kafka.Message{
+		Headers: []kafka.Header{{Key: "X-Proto-Type", Value: []byte(pMsg.ProtoReflect().Descriptor().FullName())}},
+		Value:   bytes,
+	})
+
+	return err
+}
+
+func (k *kafkaNotifier) SpectatorTicketDeleted(ctx context.Context, ticket *pb.Ticket, reason matchmakerpb.SpectatorTicketDeletedMessage_Reason) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pMsg := &matchmakerpb.SpectatorTicketDeletedMessage{Ticket: ticket, Reason: reason}
+	bytes, err := proto.Marshal(pMsg)
+	if err != nil {
+		return err
+	}
+
+	err = k.w.WriteMessages(ctx, kafka.Message{
+		Headers: []kafka.Header{{Key: "X-Proto-Type", Value: []byte(pMsg.ProtoReflect().Descriptor().FullName())}},
+		Value:   bytes,
+	})
+
+	return err
+}
+
+func (k *kafkaNotifier) GameModeClosed(ctx context.Context, gameModeId string, tickets []*pb.Ticket, nextOpenTime *time.Time) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pMsg := &matchmakerpb.GameModeClosedMessage{GameModeId: gameModeId, Tickets: tickets}
+	if nextOpenTime != nil {
+		pMsg.NextOpenTime = timestamppb.New(*nextOpenTime)
+	}
+
+	bytes, err := proto.Marshal(pMsg)
+	if err != nil {
+		return err
+	}
+
+	err = k.w.WriteMessages(ctx, kafka.Message{
+		Headers: []kafka.Header{{Key: "X-Proto-Type", Value: []byte(pMsg.ProtoReflect().Descriptor().FullName())}},
+		Value:   bytes,
+	})
+
+	return err
+}
@@ -0,0 +1,29 @@
+package modeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Duration is a time.Duration written as a duration string in JSON, e.g. "24h" or "90m"
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return fmt.Errorf("duration must be a string such as \"24h\": %w", err)
+	}
+
+	parsed, err := time.ParseDuration(str)
+	if err != nil {
+		return fmt.Errorf("invalid duration: %w", err)
+	}
+
+	*d = Duration(parsed)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
@@ -0,0 +1,211 @@
+package modeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/emortalmc/live-config-parser/golang/pkg/liveconfig"
+	"go.uber.org/zap"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultPath is the same directory the liveconfig game mode controller loads from.
+const DefaultPath = "./config/gamemodes"
+
+// ModeConfig holds the matchmaker specific settings of a game mode.
+// They live in the same file as the liveconfig.GameModeConfig, which ignores fields it doesn't know about.
+type ModeConfig struct {
+	Id string `json:"id"`
+
+	// QueueRules optional
+	QueueRules *QueueRules `json:"queueRules"`
+
+	// Schedule optional, the mode is always open if not set
+	Schedule *Schedule `json:"schedule"`
+
+	// Rejoin optional, players can't rejoin a match of the mode if not set
+	Rejoin *RejoinConfig `json:"rejoin"`
+}
+
+// MaxRejoinWindow is how long match records are kept for, regardless of the mode's window.
+const MaxRejoinWindow = 24 * time.Hour
+
+type RejoinConfig struct {
+	// Window optional, how long after a match is created players can rejoin it, e.g. "30m". Defaults to 1 hour.
+	Window Duration `json:"window"`
+}
+
+// AllowsRejoin safe to call on a nil config.
+func (c *ModeConfig) AllowsRejoin() bool {
+	return c != nil && c.Rejoin != nil
+}
+
+// CanRejoin returns whether a player can still rejoin a match of the mode that was created at createdAt.
+// Safe to call on a nil config.
+func (c *ModeConfig) CanRejoin(createdAt time.Time) bool {
+	if !c.AllowsRejoin() {
+		return false
+	}
+
+	window := time.Duration(c.Rejoin.Window)
+	if window <= 0 {
+		window = time.Hour
+	}
+	if window > MaxRejoinWindow {
+		window = MaxRejoinWindow
+	}
+
+	return time.Since(createdAt) < window
+}
+
+// IsOpen returns whether the mode's schedule allows queueing at t. Safe to call on a nil config.
+func (c *ModeConfig) IsOpen(t time.Time) bool {
+	if c == nil || c.Schedule == nil {
+		return true
+	}
+
+	return c.Schedule.IsOpen(t)
+}
+
+// NextOpenLookahead is how far ahead NextOpen is checked when telling players when a closed mode opens
+const NextOpenLookahead = 31 * 24 * time.Hour
+
+// NextOpen returns when the mode next opens within lookahead of now, or false if it doesn't.
+// Safe to call on a nil config.
+func (c *ModeConfig) NextOpen(now time.Time, lookahead time.Duration) (time.Time, bool) {
+	if c == nil || c.Schedule == nil {
+		return now, true
+	}
+
+	intervals := c.Schedule.Intervals(now, now.Add(lookahead))
+	if len(intervals) == 0 {
+		return time.Time{}, false
+	}
+
+	return intervals[0].Start, true
+}
+
+// NextScheduleChange returns when the mode next opens or closes within lookahead of now, or nil if it doesn't.
+// Safe to call on a nil config.
+func (c *ModeConfig) NextScheduleChange(now time.Time, lookahead time.Duration) *time.Time {
+	if c == nil || c.Schedule == nil {
+		return nil
+	}
+
+	to := now.Add(lookahead)
+	for _, interval := range c.Schedule.Intervals(now, to) {
+		if interval.Start.After(now) {
+			return &interval.Start
+		}
+		// The end is clipped to the lookahead, so it is only a change if it's before that
+		if interval.End.Before(to) {
+			return &interval.End
+		}
+	}
+
+	return nil
+}
+
+// QueueRules are checked against every member of a party when the party queues for a game mode.
+type QueueRules struct {
+	// Permission optional, a permission node every member must have
+	Permission *string `json:"permission"`
+	// MinLevel optional, the experience level every member must have reached
+	MinLevel *int `json:"minLevel"`
+
+	// MaxPartySizes optional, map[roleId]maxPartySize.
+	// The leader's highest limit applies. If the leader has none of the roles, only the partyRestrictions apply.
+	MaxPartySizes map[string]int `json:"maxPartySizes"`
+}
+
+type Controller interface {
+	// GetConfig returns the matchmaker settings of a game mode or nil if there are none.
+	GetConfig(id string) *ModeConfig
+}
+
+type controllerImpl struct {
+	logger *zap.SugaredLogger
+	path   string
+
+	configs     map[string]*ModeConfig
+	configsLock sync.RWMutex
+}
+
+// NewController loads the settings from path and reloads them whenever the game mode controller sees a change.
+func NewController(logger *zap.SugaredLogger, gameModeController liveconfig.GameModeConfigController, path string) (Controller, error) {
+	configs, err := load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &controllerImpl{
+		logger:  logger,
+		path:    path,
+		configs: configs,
+	}
+
+	// The update doesn't tell us which file changed, so just reload them all. There are only a handful.
+	gameModeController.AddGlobalUpdateListener(func(update liveconfig.ConfigUpdate[liveconfig.GameModeConfig]) {
+		c.reload()
+	})
+
+	return c, nil
+}
+
+func (c *controllerImpl) GetConfig(id string) *ModeConfig {
+	c.configsLock.RLock()
+	defer c.configsLock.RUnlock()
+
+	return c.configs[id]
+}
+
+func (c *controllerImpl) reload() {
+	configs, err := load(c.path)
+	if err != nil {
+		c.logger.Errorw("failed to reload game mode matchmaker configs", "path", c.path, "error", err)
+		return
+	}
+
+	c.configsLock.Lock()
+	defer c.configsLock.Unlock()
+
+	c.configs = configs
+}
+
+func load(path string) (map[string]*ModeConfig, error) {
+	configs := make(map[string]*ModeConfig)
+
+	err := filepath.Walk(path, func(path string, d fs.FileInfo, err error) error {
+		if d == nil || d.IsDir() || strings.HasPrefix(path, ".") || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+
+		bytes, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read file (path: %s): %w", path, err)
+		}
+
+		var config ModeConfig
+		if err := json.Unmarshal(bytes, &config); err != nil {
+			return fmt.Errorf("failed to parse config (path: %s): %w", path, err)
+		}
+
+		if config.Schedule != nil {
+			if err := config.Schedule.validate(); err != nil {
+				return fmt.Errorf("invalid schedule (path: %s): %w", path, err)
+			}
+		}
+
+		configs[config.Id] = &config
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
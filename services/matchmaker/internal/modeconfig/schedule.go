@@ -0,0 +1,248 @@
+package modeconfig
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Schedule restricts when a game mode can be queued for. Every part that is set has to be open for the mode to be open.
+type Schedule struct {
+	// Timezone optional, the IANA name of the timezone Windows are in. Defaults to UTC.
+	Timezone string `json:"timezone"`
+
+	// From optional, the mode is closed before this time. Used for limited-time modes.
+	From *time.Time `json:"from"`
+	// Until optional, the mode is closed from this time.
+	Until *time.Time `json:"until"`
+
+	// Windows optional, the mode is only open during these weekly windows.
+	Windows []*WeeklyWindow `json:"windows"`
+
+	// Rotation optional, the mode is only open during its slot of the rotation.
+	Rotation *Rotation `json:"rotation"`
+
+	location *time.Location
+}
+
+// WeeklyWindow e.g. FRIDAY 18:00 to MONDAY 00:00 for a weekend mode.
+// The window may wrap around the end of the week.
+type WeeklyWindow struct {
+	OpenDay   string `json:"openDay"`
+	OpenTime  string `json:"openTime"`
+	CloseDay  string `json:"closeDay"`
+	CloseTime string `json:"closeTime"`
+
+	openDay   time.Weekday
+	openTime  time.Duration
+	closeDay  time.Weekday
+	closeTime time.Duration
+}
+
+// Rotation splits time into periods starting at Start and cycling through Slots.
+// A daily rotating featured mode is a set of modes with the same Start, Period ("24h") and Slots, each with its own Slot.
+type Rotation struct {
+	Start  time.Time `json:"start"`
+	Period Duration  `json:"period"`
+	Slots  int       `json:"slots"`
+	Slot   int       `json:"slot"`
+}
+
+// Interval is a time range the mode is open for, Start inclusive and End exclusive.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+const week = 7 * 24 * time.Hour
+
+var weekdays = map[string]time.Weekday{
+	"SUNDAY":    time.Sunday,
+	"MONDAY":    time.Monday,
+	"TUESDAY":   time.Tuesday,
+	"WEDNESDAY": time.Wednesday,
+	"THURSDAY":  time.Thursday,
+	"FRIDAY":    time.Friday,
+	"SATURDAY":  time.Saturday,
+}
+
+func (s *Schedule) validate() error {
+	s.location = time.UTC
+	if s.Timezone != "" {
+		loc, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return fmt.Errorf("invalid timezone: %w", err)
+		}
+		s.location = loc
+	}
+
+	if s.From != nil && s.Until != nil && !s.From.Before(*s.Until) {
+		return fmt.Errorf("from must be before until")
+	}
+
+	for _, window := range s.Windows {
+		var err error
+		if window.openDay, window.openTime, err = parseDayTime(window.OpenDay, window.OpenTime); err != nil {
+			return fmt.Errorf("invalid window open: %w", err)
+		}
+		if window.closeDay, window.closeTime, err = parseDayTime(window.CloseDay, window.CloseTime); err != nil {
+			return fmt.Errorf("invalid window close: %w", err)
+		}
+	}
+
+	if r := s.Rotation; r != nil {
+		if r.Period <= 0 || r.Slots <= 0 || r.Slot < 0 || r.Slot >= r.Slots {
+			return fmt.Errorf("invalid rotation (period: %s, slots: %d, slot: %d)", time.Duration(r.Period), r.Slots, r.Slot)
+		}
+	}
+
+	return nil
+}
+
+func parseDayTime(day string, clock string) (time.Weekday, time.Duration, error) {
+	weekday, ok := weekdays[strings.ToUpper(day)]
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid day %s", day)
+	}
+
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time %s: %w", clock, err)
+	}
+
+	return weekday, time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+// IsOpen returns whether the mode can be queued for at t.
+func (s *Schedule) IsOpen(t time.Time) bool {
+	return len(s.Intervals(t, t.Add(time.Nanosecond))) > 0
+}
+
+// Intervals returns the sorted, non-overlapping intervals the mode is open for, clipped to [from, to).
+func (s *Schedule) Intervals(from time.Time, to time.Time) []Interval {
+	intervals := []Interval{{Start: from, End: to}}
+
+	if s.From != nil || s.Until != nil {
+		limit := Interval{Start: from, End: to}
+		if s.From != nil && s.From.After(from) {
+			limit.Start = *s.From
+		}
+		if s.Until != nil && s.Until.Before(to) {
+			limit.End = *s.Until
+		}
+		intervals = intersect(intervals, []Interval{limit})
+	}
+
+	if len(s.Windows) > 0 {
+		intervals = intersect(intervals, s.windowIntervals(from, to))
+	}
+
+	if s.Rotation != nil {
+		intervals = intersect(intervals, s.Rotation.intervals(from, to))
+	}
+
+	return intervals
+}
+
+func (s *Schedule) windowIntervals(from time.Time, to time.Time) []Interval {
+	local := from.In(s.location)
+	// Start a week early to catch windows that wrap into the first week.
+	year, month, day := local.AddDate(0, 0, -int(local.Weekday())-7).Date()
+
+	intervals := make([]Interval, 0)
+	for weekStart := 0; ; weekStart += 7 {
+		if time.Date(year, month, day+weekStart, 0, 0, 0, 0, s.location).After(to) {
+			break
+		}
+
+		for _, window := range s.Windows {
+			open := s.wallTime(year, month, day+weekStart+int(window.openDay), window.openTime)
+			closeDay := day + weekStart + int(window.closeDay)
+			closeAt := s.wallTime(year, month, closeDay, window.closeTime)
+			if !closeAt.After(open) {
+				closeAt = s.wallTime(year, month, closeDay+7, window.closeTime)
+			}
+
+			intervals = append(intervals, Interval{Start: open, End: closeAt})
+		}
+	}
+
+	return merge(intervals)
+}
+
+// wallTime is the time of day clock on the given day in the schedule's timezone.
+// It isn't midnight plus clock, which is an hour off on days the clocks change.
+func (s *Schedule) wallTime(year int, month time.Month, day int, clock time.Duration) time.Time {
+	return time.Date(year, month, day, int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0, s.location)
+}
+
+func (r *Rotation) intervals(from time.Time, to time.Time) []Interval {
+	period := time.Duration(r.Period)
+	first := int64(from.Sub(r.Start) / period)
+	if from.Before(r.Start) {
+		first = 0
+	}
+
+	intervals := make([]Interval, 0)
+	for i := first; ; i++ {
+		start := r.Start.Add(time.Duration(i) * period)
+		if !start.Before(to) {
+			break
+		}
+
+		if int(i%int64(r.Slots)) == r.Slot {
+			intervals = append(intervals, Interval{Start: start, End: start.Add(period)})
+		}
+	}
+
+	return intervals
+}
+
+func merge(intervals []Interval) []Interval {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Start.Before(intervals[j].Start)
+	})
+
+	merged := make([]Interval, 0, len(intervals))
+	for _, interval := range intervals {
+		last := len(merged) - 1
+		if last >= 0 && !interval.Start.After(merged[last].End) {
+			if interval.End.After(merged[last].End) {
+				merged[last].End = interval.End
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+
+	return merged
+}
+
+// intersect expects both a and b to be sorted and non-overlapping.
+func intersect(a []Interval, b []Interval) []Interval {
+	result := make([]Interval, 0)
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start := a[i].Start
+		if b[j].Start.After(start) {
+			start = b[j].Start
+		}
+		end := a[i].End
+		if b[j].End.Before(end) {
+			end = b[j].End
+		}
+
+		if start.Before(end) {
+			result = append(result, Interval{Start: start, End: end})
+		}
+
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return result
+}
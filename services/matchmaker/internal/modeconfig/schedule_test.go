@@ -0,0 +1,334 @@
+package modeconfig
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func date(month time.Month, day int, hour int, minute int) time.Time {
+	return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func toUTC(intervals []Interval) []Interval {
+	for i := range intervals {
+		intervals[i] = Interval{Start: intervals[i].Start.UTC(), End: intervals[i].End.UTC()}
+	}
+	return intervals
+}
+
+func TestSchedule_Intervals(t *testing.T) {
+	from := date(time.January, 5, 12, 0)
+	until := date(time.January, 6, 0, 0)
+
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		from     time.Time
+		to       time.Time
+
+		want []Interval
+	}{
+		{
+			name:     "no restrictions",
+			schedule: &Schedule{},
+			from:     date(time.January, 5, 0, 0),
+			to:       date(time.January, 6, 0, 0),
+			want:     []Interval{{Start: date(time.January, 5, 0, 0), End: date(time.January, 6, 0, 0)}},
+		},
+		{
+			name:     "from and until",
+			schedule: &Schedule{From: &from, Until: &until},
+			from:     date(time.January, 5, 0, 0),
+			to:       date(time.January, 7, 0, 0),
+			want:     []Interval{{Start: from, End: until}},
+		},
+		{
+			name:     "window over midnight",
+			schedule: &Schedule{Windows: []*WeeklyWindow{{OpenDay: "FRIDAY", OpenTime: "22:00", CloseDay: "SATURDAY", CloseTime: "02:00"}}},
+			from:     date(time.January, 5, 0, 0),
+			to:       date(time.January, 7, 0, 0),
+			want:     []Interval{{Start: date(time.January, 5, 22, 0), End: date(time.January, 6, 2, 0)}},
+		},
+		{
+			name:     "window closing at midnight",
+			schedule: &Schedule{Windows: []*WeeklyWindow{{OpenDay: "FRIDAY", OpenTime: "18:00", CloseDay: "MONDAY", CloseTime: "00:00"}}},
+			from:     date(time.January, 5, 0, 0),
+			to:       date(time.January, 9, 0, 0),
+			want:     []Interval{{Start: date(time.January, 5, 18, 0), End: date(time.January, 8, 0, 0)}},
+		},
+		{
+			name:     "window wrapping the end of the week",
+			schedule: &Schedule{Windows: []*WeeklyWindow{{OpenDay: "SATURDAY", OpenTime: "22:00", CloseDay: "SUNDAY", CloseTime: "02:00"}}},
+			from:     date(time.January, 6, 0, 0),
+			to:       date(time.January, 8, 0, 0),
+			want:     []Interval{{Start: date(time.January, 6, 22, 0), End: date(time.January, 7, 2, 0)}},
+		},
+		{
+			name:     "window opened before from",
+			schedule: &Schedule{Windows: []*WeeklyWindow{{OpenDay: "SATURDAY", OpenTime: "22:00", CloseDay: "SUNDAY", CloseTime: "02:00"}}},
+			from:     date(time.January, 7, 1, 0),
+			to:       date(time.January, 7, 12, 0),
+			want:     []Interval{{Start: date(time.January, 7, 1, 0), End: date(time.January, 7, 2, 0)}},
+		},
+		{
+			name: "overlapping windows merged",
+			schedule: &Schedule{Windows: []*WeeklyWindow{
+				{OpenDay: "MONDAY", OpenTime: "10:00", CloseDay: "MONDAY", CloseTime: "12:00"},
+				{OpenDay: "MONDAY", OpenTime: "11:00", CloseDay: "MONDAY", CloseTime: "13:00"},
+			}},
+			from: date(time.January, 8, 0, 0),
+			to:   date(time.January, 9, 0, 0),
+			want: []Interval{{Start: date(time.January, 8, 10, 0), End: date(time.January, 8, 13, 0)}},
+		},
+		{
+			// Clocks go forward at 01:00 UTC, so the window is an hour shorter
+			name: "window over DST start",
+			schedule: &Schedule{Timezone: "Europe/London", Windows: []*WeeklyWindow{
+				{OpenDay: "SUNDAY", OpenTime: "00:00", CloseDay: "SUNDAY", CloseTime: "04:00"},
+			}},
+			from: date(time.March, 30, 0, 0),
+			to:   date(time.April, 1, 0, 0),
+			want: []Interval{{Start: date(time.March, 31, 0, 0), End: date(time.March, 31, 3, 0)}},
+		},
+		{
+			// Clocks go back at 01:00 UTC, so the window is an hour longer
+			name: "window over DST end",
+			schedule: &Schedule{Timezone: "Europe/London", Windows: []*WeeklyWindow{
+				{OpenDay: "SUNDAY", OpenTime: "00:00", CloseDay: "SUNDAY", CloseTime: "04:00"},
+			}},
+			from: date(time.October, 26, 0, 0),
+			to:   date(time.October, 28, 0, 0),
+			want: []Interval{{Start: date(time.October, 26, 23, 0), End: date(time.October, 27, 4, 0)}},
+		},
+		{
+			name: "window and rotation",
+			schedule: &Schedule{
+				Windows:  []*WeeklyWindow{{OpenDay: "FRIDAY", OpenTime: "18:00", CloseDay: "MONDAY", CloseTime: "00:00"}},
+				Rotation: &Rotation{Start: date(time.January, 1, 0, 0), Period: Duration(24 * time.Hour), Slots: 2, Slot: 0},
+			},
+			from: date(time.January, 5, 0, 0),
+			to:   date(time.January, 8, 0, 0),
+			want: []Interval{
+				{Start: date(time.January, 5, 18, 0), End: date(time.January, 6, 0, 0)},
+				{Start: date(time.January, 7, 0, 0), End: date(time.January, 8, 0, 0)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, tt.schedule.validate())
+			assert.Equal(t, tt.want, toUTC(tt.schedule.Intervals(tt.from, tt.to)))
+		})
+	}
+}
+
+func TestSchedule_validate(t *testing.T) {
+	from := date(time.January, 2, 0, 0)
+	until := date(time.January, 1, 0, 0)
+
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			schedule: &Schedule{
+				Timezone: "Europe/London",
+				Windows:  []*WeeklyWindow{{OpenDay: "friday", OpenTime: "18:00", CloseDay: "MONDAY", CloseTime: "00:00"}},
+				Rotation: &Rotation{Period: Duration(time.Hour), Slots: 3, Slot: 2},
+			},
+		},
+		{
+			name:     "unknown timezone",
+			schedule: &Schedule{Timezone: "Mars/Olympus"},
+			wantErr:  true,
+		},
+		{
+			name:     "from after until",
+			schedule: &Schedule{From: &from, Until: &until},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown day",
+			schedule: &Schedule{Windows: []*WeeklyWindow{{OpenDay: "FUNDAY", OpenTime: "18:00", CloseDay: "MONDAY", CloseTime: "00:00"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid time",
+			schedule: &Schedule{Windows: []*WeeklyWindow{{OpenDay: "FRIDAY", OpenTime: "25:00", CloseDay: "MONDAY", CloseTime: "00:00"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "rotation slot out of range",
+			schedule: &Schedule{Rotation: &Rotation{Period: Duration(time.Hour), Slots: 2, Slot: 2}},
+			wantErr:  true,
+		},
+		{
+			name:     "rotation without period",
+			schedule: &Schedule{Rotation: &Rotation{Slots: 2}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schedule.validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name      string
+		intervals []Interval
+		want      []Interval
+	}{
+		{
+			name:      "empty",
+			intervals: []Interval{},
+			want:      []Interval{},
+		},
+		{
+			name: "unsorted and disjoint",
+			intervals: []Interval{
+				{Start: date(time.January, 3, 0, 0), End: date(time.January, 4, 0, 0)},
+				{Start: date(time.January, 1, 0, 0), End: date(time.January, 2, 0, 0)},
+			},
+			want: []Interval{
+				{Start: date(time.January, 1, 0, 0), End: date(time.January, 2, 0, 0)},
+				{Start: date(time.January, 3, 0, 0), End: date(time.January, 4, 0, 0)},
+			},
+		},
+		{
+			name: "touching",
+			intervals: []Interval{
+				{Start: date(time.January, 1, 0, 0), End: date(time.January, 2, 0, 0)},
+				{Start: date(time.January, 2, 0, 0), End: date(time.January, 3, 0, 0)},
+			},
+			want: []Interval{{Start: date(time.January, 1, 0, 0), End: date(time.January, 3, 0, 0)}},
+		},
+		{
+			name: "contained",
+			intervals: []Interval{
+				{Start: date(time.January, 1, 0, 0), End: date(time.January, 5, 0, 0)},
+				{Start: date(time.January, 2, 0, 0), End: date(time.January, 3, 0, 0)},
+			},
+			want: []Interval{{Start: date(time.January, 1, 0, 0), End: date(time.January, 5, 0, 0)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, merge(tt.intervals))
+		})
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []Interval
+		b    []Interval
+		want []Interval
+	}{
+		{
+			name: "disjoint",
+			a:    []Interval{{Start: date(time.January, 1, 0, 0), End: date(time.January, 2, 0, 0)}},
+			b:    []Interval{{Start: date(time.January, 2, 0, 0), End: date(time.January, 3, 0, 0)}},
+			want: []Interval{},
+		},
+		{
+			name: "overlapping",
+			a:    []Interval{{Start: date(time.January, 1, 0, 0), End: date(time.January, 3, 0, 0)}},
+			b:    []Interval{{Start: date(time.January, 2, 0, 0), End: date(time.January, 4, 0, 0)}},
+			want: []Interval{{Start: date(time.January, 2, 0, 0), End: date(time.January, 3, 0, 0)}},
+		},
+		{
+			name: "one spanning several",
+			a:    []Interval{{Start: date(time.January, 1, 0, 0), End: date(time.January, 10, 0, 0)}},
+			b: []Interval{
+				{Start: date(time.January, 2, 0, 0), End: date(time.January, 3, 0, 0)},
+				{Start: date(time.January, 9, 0, 0), End: date(time.January, 11, 0, 0)},
+			},
+			want: []Interval{
+				{Start: date(time.January, 2, 0, 0), End: date(time.January, 3, 0, 0)},
+				{Start: date(time.January, 9, 0, 0), End: date(time.January, 10, 0, 0)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, intersect(tt.a, tt.b))
+		})
+	}
+}
+
+func TestModeConfig_NextOpen(t *testing.T) {
+	now := date(time.January, 5, 12, 0) // Friday
+	afterLookahead := now.Add(NextOpenLookahead + time.Hour)
+
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		nilCfg   bool
+
+		want   time.Time
+		wantOk bool
+	}{
+		{
+			name:   "nil config",
+			nilCfg: true,
+			want:   now,
+			wantOk: true,
+		},
+		{
+			name:   "no schedule",
+			want:   now,
+			wantOk: true,
+		},
+		{
+			name:     "open now",
+			schedule: &Schedule{Windows: []*WeeklyWindow{{OpenDay: "FRIDAY", OpenTime: "00:00", CloseDay: "SATURDAY", CloseTime: "00:00"}}},
+			want:     now,
+			wantOk:   true,
+		},
+		{
+			name:     "opens later",
+			schedule: &Schedule{Windows: []*WeeklyWindow{{OpenDay: "FRIDAY", OpenTime: "18:00", CloseDay: "MONDAY", CloseTime: "00:00"}}},
+			want:     date(time.January, 5, 18, 0),
+			wantOk:   true,
+		},
+		{
+			name:     "doesn't open within lookahead",
+			schedule: &Schedule{From: &afterLookahead},
+			wantOk:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ModeConfig{Schedule: tt.schedule}
+			if tt.nilCfg {
+				cfg = nil
+			}
+			if tt.schedule != nil {
+				assert.NoError(t, tt.schedule.validate())
+			}
+
+			got, ok := cfg.NextOpen(now, NextOpenLookahead)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got.UTC())
+			}
+		})
+	}
+}
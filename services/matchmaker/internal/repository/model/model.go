@@ -44,6 +44,9 @@ type Ticket struct {
 
 	AutoTeleport bool `bson:"autoTeleport"`
 
+	// Spectate is only present for spectator tickets. They aren't matched, they're sent to the target match's server.
+	Spectate *SpectateTarget `bson:"spectate,omitempty"`
+
 	InternalUpdates *TicketInternalUpdates `bson:"-"`
 }
 
@@ -84,6 +87,27 @@ func (t *Ticket) ToProto() *pb.Ticket {
 	}
 }
 
+// NewSpectatorTicket creates a ticket that lets a single player spectate a match.
+func NewSpectatorTicket(playerId uuid.UUID, gameModeId string, matchId string) *Ticket {
+	return &Ticket{
+		Id: primitive.NewObjectID(),
+		PartySettings: &ReducedPartySettings{
+			LeaderId:            playerId,
+			DequeueOnDisconnect: true,
+			AllowMemberDequeue:  true,
+		},
+		PlayerIds:    []uuid.UUID{playerId},
+		GameModeId:   gameModeId,
+		AutoTeleport: true,
+		Spectate:     &SpectateTarget{MatchId: matchId},
+	}
+}
+
+type SpectateTarget struct {
+	// MatchId is resolved when queueing, even if the player queued by a target player.
+	MatchId string `bson:"matchId"`
+}
+
 type TicketRemovals struct {
 	MarkedForRemoval  bool        `bson:"markedForRemoval"`
 	PlayersForRemoval []uuid.UUID `bson:"playersForRemoval"`
@@ -160,3 +184,67 @@ type ServerInfo struct {
 	Port    uint32 `bson:"port"`
 	Name    string `bson:"name"`
 }
+
+// MatchRecord is kept for every allocated match so players can rejoin it after disconnecting (if the mode allows it)
+// or spectate it. It is deleted when the game finishes.
+type MatchRecord struct {
+	Id      primitive.ObjectID `bson:"_id"`
+	MatchId string             `bson:"matchId"`
+
+	GameModeId string  `bson:"gameModeId"`
+	MapId      *string `bson:"mapId,omitempty"`
+
+	PlayerIds  []uuid.UUID      `bson:"playerIds"`
+	Assignment *MatchAssignment `bson:"assignment"`
+
+	CreatedAt time.Time `bson:"createdAt"`
+}
+
+type MatchAssignment struct {
+	ServerId        string  `bson:"serverId"`
+	ServerAddress   string  `bson:"serverAddress"`
+	ServerPort      uint32  `bson:"serverPort"`
+	ProtocolVersion *int64  `bson:"protocolVersion,omitempty"`
+	VersionName     *string `bson:"versionName,omitempty"`
+}
+
+// NewMatchRecord expects the match to have an assignment.
+func NewMatchRecord(match *pb.Match) (*MatchRecord, error) {
+	playerIds := make([]uuid.UUID, 0)
+	for _, ticket := range match.Tickets {
+		for _, id := range ticket.PlayerIds {
+			playerId, err := uuid.Parse(id)
+			if err != nil {
+				return nil, err
+			}
+			playerIds = append(playerIds, playerId)
+		}
+	}
+
+	assignment := match.Assignment
+	return &MatchRecord{
+		Id:         primitive.NewObjectID(),
+		MatchId:    match.Id,
+		GameModeId: match.GameModeId,
+		MapId:      match.MapId,
+		PlayerIds:  playerIds,
+		Assignment: &MatchAssignment{
+			ServerId:        assignment.ServerId,
+			ServerAddress:   assignment.ServerAddress,
+			ServerPort:      assignment.ServerPort,
+			ProtocolVersion: assignment.ProtocolVersion,
+			VersionName:     assignment.VersionName,
+		},
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+func (a *MatchAssignment) ToProto() *pb.Assignment {
+	return &pb.Assignment{
+		ServerId:        a.ServerId,
+		ServerAddress:   a.ServerAddress,
+		ServerPort:      a.ServerPort,
+		ProtocolVersion: a.ProtocolVersion,
+		VersionName:     a.VersionName,
+	}
+}
@@ -25,6 +25,7 @@ type mongoRepository struct {
 	ticketCollection       *mongo.Collection
 	pendingMatchCollection *mongo.Collection
 	backfillCollection     *mongo.Collection
+	matchRecordCollection  *mongo.Collection
 }
 
 func NewMongoRepository(ctx context.Context, wg *sync.WaitGroup, logger *zap.SugaredLogger, cfg config.MongoDBConfig) (Repository, error) {
@@ -42,6 +43,7 @@ func NewMongoRepository(ctx context.Context, wg *sync.WaitGroup, logger *zap.Sug
 		ticketCollection:       database.Collection(ticketCollectionName),
 		pendingMatchCollection: database.Collection(pendingMatchCollectionName),
 		backfillCollection:     database.Collection(backfillCollectionName),
+		matchRecordCollection:  database.Collection(matchRecordCollectionName),
 	}
 
 	wg.Add(1)
@@ -95,12 +97,33 @@ var (
 			Options: options.Index().SetName("ticketIds"),
 		},
 	}
+
+	matchRecordIndexes = []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "playerIds", Value: 1}, {Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("playerIds_createdAt"),
+		},
+		{
+			Keys:    bson.M{"matchId": 1},
+			Options: options.Index().SetName("matchId"),
+		},
+		{
+			Keys:    bson.M{"assignment.serverId": 1},
+			Options: options.Index().SetName("assignment.serverId"),
+		},
+		{
+			// Safety net in case we never see the game finish (e.g. the server crashed)
+			Keys:    bson.M{"createdAt": 1},
+			Options: options.Index().SetName("createdAt_ttl").SetExpireAfterSeconds(int32((24 * time.Hour).Seconds())),
+		},
+	}
 )
 
 func (m *mongoRepository) createIndexes(ctx context.Context) {
 	collIndexes := map[*mongo.Collection][]mongo.IndexModel{
 		m.ticketCollection:       ticketIndexes,
 		m.pendingMatchCollection: pendingMatchIndexes,
+		m.matchRecordCollection:  matchRecordIndexes,
 	}
 
 	wg := sync.WaitGroup{}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository/model"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+func (m *mongoRepository) CreateMatchRecords(ctx context.Context, records []*model.MatchRecord) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	converted := make([]interface{}, len(records))
+	for i, record := range records {
+		converted[i] = record
+	}
+
+	_, err := m.matchRecordCollection.InsertMany(ctx, converted)
+	return err
+}
+
+func (m *mongoRepository) GetLatestMatchRecordByPlayerId(ctx context.Context, playerId uuid.UUID) (*model.MatchRecord, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var record model.MatchRecord
+	err := m.matchRecordCollection.FindOne(ctx, bson.M{"playerIds": playerId},
+		options.FindOne().SetSort(bson.M{"createdAt": -1})).Decode(&record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (m *mongoRepository) GetMatchRecordByMatchId(ctx context.Context, matchId string) (*model.MatchRecord, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var record model.MatchRecord
+	if err := m.matchRecordCollection.FindOne(ctx, bson.M{"matchId": matchId}).Decode(&record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+func (m *mongoRepository) DeleteMatchRecordsByServer(ctx context.Context, serverId string, playerIds []uuid.UUID) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"assignment.serverId": serverId,
+		"playerIds":           bson.M{"$in": playerIds},
+	}
+
+	cursor, err := m.matchRecordCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"matchId": 1}))
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*model.MatchRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]primitive.ObjectID, len(records))
+	matchIds := make([]string, len(records))
+	for i, record := range records {
+		ids[i] = record.Id
+		matchIds[i] = record.MatchId
+	}
+
+	if _, err := m.matchRecordCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return nil, err
+	}
+
+	return matchIds, nil
+}
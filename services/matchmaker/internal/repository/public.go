@@ -16,6 +16,7 @@ const (
 	ticketCollectionName       = "ticket"
 	pendingMatchCollectionName = "pendingMatch"
 	backfillCollectionName     = "backfill"
+	matchRecordCollectionName  = "matchRecord"
 )
 
 type Repository interface {
@@ -82,4 +83,18 @@ type Repository interface {
 	// RemoveTicketsFromPendingMatchesById removes ticket IDs from PendingMatches they are present in.
 	// returns: int64, the modified count.
 	RemoveTicketsFromPendingMatchesById(ctx context.Context, ticketIds []primitive.ObjectID) (int64, error)
+
+	// MatchRecord
+
+	CreateMatchRecords(ctx context.Context, records []*model.MatchRecord) error
+
+	// GetLatestMatchRecordByPlayerId throws mongo.ErrNoDocuments if the player has no match record.
+	GetLatestMatchRecordByPlayerId(ctx context.Context, playerId uuid.UUID) (*model.MatchRecord, error)
+
+	// GetMatchRecordByMatchId throws mongo.ErrNoDocuments if the match has no record.
+	GetMatchRecordByMatchId(ctx context.Context, matchId string) (*model.MatchRecord, error)
+
+	// DeleteMatchRecordsByServer deletes the records on the server that contain any of the players.
+	// returns: []string, the match ids of the deleted records.
+	DeleteMatchRecordsByServer(ctx context.Context, serverId string, playerIds []uuid.UUID) ([]string, error)
 }
@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/emortalmc/live-config-parser/golang/pkg/liveconfig"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/eligibility"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/kafka"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/modeconfig"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository/model"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/simplecontroller"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/trigger"
 	"github.com/emortalmc/proto-specs/gen/go/grpc/matchmaker"
 	pbparty "github.com/emortalmc/proto-specs/gen/go/grpc/party"
 	pb "github.com/emortalmc/proto-specs/gen/go/model/matchmaker"
@@ -18,40 +23,51 @@ import (
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"sync"
+	"time"
 )
 
 type matchmakerService struct {
 	matchmaker.UnimplementedMatchmakerServer
 
-	logger        *zap.SugaredLogger
-	repo          repository.Repository
-	notifier      kafka.Notifier
-	cfgController liveconfig.GameModeConfigController
+	logger            *zap.SugaredLogger
+	repo              repository.Repository
+	notifier          kafka.Notifier
+	cfgController     liveconfig.GameModeConfigController
+	modeCfgController modeconfig.Controller
 
 	lobbyController    simplecontroller.SimpleController
 	velocityController simplecontroller.SimpleController
 
 	partyService         pbparty.PartyServiceClient
 	partySettingsService pbparty.PartySettingsServiceClient
+
+	eligibilityChecker eligibility.Checker
+	directorTrigger    trigger.Trigger
 }
 
 func newMatchmakerService(logger *zap.SugaredLogger, repository repository.Repository, notifier kafka.Notifier,
-	cfgController liveconfig.GameModeConfigController, lobbyController simplecontroller.SimpleController,
-	velocityController simplecontroller.SimpleController,
-	partyService pbparty.PartyServiceClient, partySettingsService pbparty.PartySettingsServiceClient) matchmaker.MatchmakerServer {
+	cfgController liveconfig.GameModeConfigController, modeCfgController modeconfig.Controller,
+	lobbyController simplecontroller.SimpleController, velocityController simplecontroller.SimpleController,
+	partyService pbparty.PartyServiceClient, partySettingsService pbparty.PartySettingsServiceClient,
+	eligibilityChecker eligibility.Checker, directorTrigger trigger.Trigger) matchmaker.MatchmakerServer {
 
 	return &matchmakerService{
-		logger:        logger,
-		repo:          repository,
-		notifier:      notifier,
-		cfgController: cfgController,
+		logger:            logger,
+		repo:              repository,
+		notifier:          notifier,
+		cfgController:     cfgController,
+		modeCfgController: modeCfgController,
 
 		lobbyController:    lobbyController,
 		velocityController: velocityController,
 
 		partyService:         partyService,
 		partySettingsService: partySettingsService,
+
+		eligibilityChecker: eligibilityChecker,
+		directorTrigger:    directorTrigger,
 	}
 }
 
@@ -75,6 +91,33 @@ var (
 				WithDetails(&matchmaker.QueueByPlayerErrorResponse{Reason: matchmaker.QueueByPlayerErrorResponse_NO_PERMISSION})).Err()
 )
 
+// createEligibilityErr keeps the QueueByPlayerErrorResponse so existing clients still get a reason they understand.
+func createEligibilityErr(failure *matchmakerpb.QueueEligibilityErrorResponse) error {
+	code := codes.PermissionDenied
+	reason := matchmaker.QueueByPlayerErrorResponse_NO_PERMISSION
+	msg := "party member does not meet the queue requirements"
+
+	if failure.Reason == matchmakerpb.QueueEligibilityErrorResponse_PARTY_TOO_LARGE_FOR_ROLE {
+		code = codes.InvalidArgument
+		reason = matchmaker.QueueByPlayerErrorResponse_PARTY_TOO_LARGE
+		msg = "party is too large for the leader's roles"
+	}
+
+	return panicIfErr(status.New(code, msg).
+		WithDetails(&matchmaker.QueueByPlayerErrorResponse{Reason: reason}, failure)).Err()
+}
+
+// createGameModeClosedErr keeps GAME_MODE_DISABLED for existing clients, the QueueScheduleErrorResponse tells them apart.
+func createGameModeClosedErr(modeCfg *modeconfig.ModeConfig, now time.Time) error {
+	detail := &matchmakerpb.QueueScheduleErrorResponse{Reason: matchmakerpb.QueueScheduleErrorResponse_GAME_MODE_CLOSED}
+	if nextOpen, ok := modeCfg.NextOpen(now, modeconfig.NextOpenLookahead); ok {
+		detail.NextOpenTime = timestamppb.New(nextOpen)
+	}
+
+	return panicIfErr(status.New(codes.FailedPrecondition, "game_mode_id is not open right now").
+		WithDetails(&matchmaker.QueueByPlayerErrorResponse{Reason: matchmaker.QueueByPlayerErrorResponse_GAME_MODE_DISABLED}, detail)).Err()
+}
+
 // QueueByPlayer requests a player is queued for a game.
 // NOTE: A player is always in a party and we clean up when a player changes party.
 // Therefore, we only need to check if the player's party is in a queue, not the player themselves.
@@ -94,6 +137,11 @@ func (m *matchmakerService) QueueByPlayer(ctx context.Context, request *matchmak
 		return nil, queueGameModeDisabledErr
 	}
 
+	modeCfg := m.modeCfgController.GetConfig(request.GameModeId)
+	if now := time.Now(); !modeCfg.IsOpen(now) {
+		return nil, createGameModeClosedErr(modeCfg, now)
+	}
+
 	// check if map is present
 	if request.MapId != nil {
 		_, ok := modeConfig.Maps[*request.MapId]
@@ -183,6 +231,18 @@ func (m *matchmakerService) QueueByPlayer(ctx context.Context, request *matchmak
 		return nil, err
 	}
 
+	// queue rules checks, every member has to pass
+	if modeCfg != nil && modeCfg.QueueRules != nil {
+		failure, err := m.eligibilityChecker.Check(ctx, modeCfg.QueueRules, partyLeaderId, memberIds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check queue rules: %w", err)
+		}
+
+		if failure != nil {
+			return nil, createEligibilityErr(failure)
+		}
+	}
+
 	// create ticket
 	ticket := model.NewTicket(&partyId, &model.ReducedPartySettings{
 		LeaderId:            partyLeaderId,
@@ -228,6 +288,8 @@ func (m *matchmakerService) QueueByPlayer(ctx context.Context, request *matchmak
 		return nil, err
 	}
 
+	m.directorTrigger.Notify(request.GameModeId)
+
 	return &matchmaker.QueueByPlayerResponse{}, nil
 }
 
@@ -287,13 +349,22 @@ func (m *matchmakerService) SendPlayersToLobby(ctx context.Context, request *mat
 	return &matchmaker.SendPlayerToLobbyResponse{}, nil
 }
 
-func (m *matchmakerService) LoginQueueByPlayer(_ context.Context, request *matchmaker.LoginQueueByPlayerRequest) (*matchmaker.LoginQueueByPlayerResponse, error) {
+func (m *matchmakerService) LoginQueueByPlayer(ctx context.Context, request *matchmaker.LoginQueueByPlayerRequest) (*matchmaker.LoginQueueByPlayerResponse, error) {
 	playerId, err := uuid.Parse(request.PlayerId)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid player_id")
 	}
 
 	if !request.IsProxy {
+		// Send the player back to their game if it's still running. Any failure falls back to a lobby.
+		rejoined, err := m.rejoinMatch(ctx, playerId)
+		if err != nil {
+			m.logger.Errorw("failed to rejoin match", "playerId", playerId, "error", err)
+		}
+		if rejoined {
+			return &matchmaker.LoginQueueByPlayerResponse{}, nil
+		}
+
 		m.lobbyController.QueuePlayer(playerId, false)
 	} else {
 		m.velocityController.QueuePlayer(playerId, false)
@@ -302,6 +373,36 @@ func (m *matchmakerService) LoginQueueByPlayer(_ context.Context, request *match
 	return &matchmaker.LoginQueueByPlayerResponse{}, nil
 }
 
+// rejoinMatch sends a MatchRejoined for the player's last match if the mode allows rejoining.
+// The record is deleted when the game finishes or is abandoned so if it exists, the game is still running.
+func (m *matchmakerService) rejoinMatch(ctx context.Context, playerId uuid.UUID) (bool, error) {
+	record, err := m.repo.GetLatestMatchRecordByPlayerId(ctx, playerId)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if m.cfgController.GetCurrentConfig(record.GameModeId) == nil || !m.modeCfgController.GetConfig(record.GameModeId).CanRejoin(record.CreatedAt) {
+		return false, nil
+	}
+
+	match := &pb.Match{
+		Id:         record.MatchId,
+		GameModeId: record.GameModeId,
+		MapId:      record.MapId,
+		Assignment: record.Assignment.ToProto(),
+	}
+
+	if err := m.notifier.MatchRejoined(ctx, playerId.String(), match); err != nil {
+		return false, fmt.Errorf("failed to notify match rejoined: %w", err)
+	}
+
+	m.logger.Infow("sent player back to their match", "playerId", playerId, "match", record.MatchId, "server", record.Assignment.ServerId)
+	return true, nil
+}
+
 var (
 	dequeueNotInQueueErr = panicIfErr(status.New(codes.NotFound, "player is not in queue").
 				WithDetails(&matchmaker.DequeueByPlayerErrorResponse{Reason: matchmaker.DequeueByPlayerErrorResponse_NOT_IN_QUEUE})).Err()
@@ -348,6 +449,8 @@ func (m *matchmakerService) DequeueByPlayer(ctx context.Context, request *matchm
 		return nil, dequeueAlreadyDequeuedErr
 	}
 
+	m.directorTrigger.Notify(ticket.GameModeId)
+
 	return &matchmaker.DequeueByPlayerResponse{}, nil
 }
 
@@ -388,6 +491,9 @@ func (m *matchmakerService) ChangePlayerMapVote(ctx context.Context, request *ma
 		return nil, err
 	}
 
+	// The vote counts towards the map of the player's next match, which the director may be about to make
+	m.directorTrigger.Notify(ticket.GameModeId)
+
 	return &matchmaker.ChangePlayerMapVoteResponse{}, nil
 }
 
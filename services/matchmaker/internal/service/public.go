@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"github.com/emortalmc/live-config-parser/golang/pkg/liveconfig"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/config"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/eligibility"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/kafka"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/modeconfig"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/simplecontroller"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/trigger"
 	"github.com/emortalmc/mono-services/services/matchmaker/internal/utils/grpczap"
 	"github.com/emortalmc/proto-specs/gen/go/grpc/matchmaker"
 	"github.com/emortalmc/proto-specs/gen/go/grpc/party"
@@ -21,8 +25,10 @@ import (
 
 func RunServices(ctx context.Context, logger *zap.SugaredLogger, wg *sync.WaitGroup, cfg config.Config,
 	repo repository.Repository, notifier kafka.Notifier, gameModeController liveconfig.GameModeConfigController,
-	lobbyCtrl simplecontroller.SimpleController, velocityCtrl simplecontroller.SimpleController,
-	partyService party.PartyServiceClient, partySettingsService party.PartySettingsServiceClient) {
+	modeConfigController modeconfig.Controller, lobbyCtrl simplecontroller.SimpleController,
+	velocityCtrl simplecontroller.SimpleController, partyService party.PartyServiceClient,
+	partySettingsService party.PartySettingsServiceClient, eligibilityChecker eligibility.Checker,
+	directorTrigger trigger.Trigger) {
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GrpcPort))
 	if err != nil {
@@ -42,8 +48,10 @@ func RunServices(ctx context.Context, logger *zap.SugaredLogger, wg *sync.WaitGr
 		reflection.Register(s)
 	}
 
-	matchmaker.RegisterMatchmakerServer(s, newMatchmakerService(logger, repo, notifier, gameModeController, lobbyCtrl,
-		velocityCtrl, partyService, partySettingsService))
+	matchmaker.RegisterMatchmakerServer(s, newMatchmakerService(logger, repo, notifier, gameModeController,
+		modeConfigController, lobbyCtrl, velocityCtrl, partyService, partySettingsService, eligibilityChecker, directorTrigger))
+	matchmakerpb.RegisterGameModeScheduleServer(s, newScheduleService(gameModeController, modeConfigController))
+	matchmakerpb.RegisterSpectatorServer(s, newSpectatorService(logger, repo, notifier, gameModeController, directorTrigger))
 	logger.Infow("listening for gRPC requests", "port", cfg.GrpcPort)
 
 	go func() {
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"github.com/emortalmc/live-config-parser/golang/pkg/liveconfig"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/modeconfig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+const (
+	defaultScheduleLookahead = 7 * 24 * time.Hour
+	maxScheduleLookahead     = 31 * 24 * time.Hour
+)
+
+type scheduleService struct {
+	matchmakerpb.UnimplementedGameModeScheduleServer
+
+	cfgController     liveconfig.GameModeConfigController
+	modeCfgController modeconfig.Controller
+}
+
+func newScheduleService(cfgController liveconfig.GameModeConfigController,
+	modeCfgController modeconfig.Controller) matchmakerpb.GameModeScheduleServer {
+
+	return &scheduleService{
+		cfgController:     cfgController,
+		modeCfgController: modeCfgController,
+	}
+}
+
+func (s *scheduleService) GetGameModeSchedule(_ context.Context, request *matchmakerpb.GetGameModeScheduleRequest) (*matchmakerpb.GetGameModeScheduleResponse, error) {
+	now := time.Now()
+
+	until := now.Add(defaultScheduleLookahead)
+	if request.Until != nil {
+		until = request.Until.AsTime()
+	}
+
+	if !until.After(now) {
+		return nil, status.Error(codes.InvalidArgument, "until must be in the future")
+	}
+	if until.Sub(now) > maxScheduleLookahead {
+		until = now.Add(maxScheduleLookahead)
+	}
+
+	configs := s.cfgController.GetCurrentConfigList()
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].Priority < configs[j].Priority
+	})
+
+	modes := make([]*matchmakerpb.ScheduledGameMode, 0)
+	for _, cfg := range configs {
+		if !cfg.Enabled {
+			continue
+		}
+
+		modeCfg := s.modeCfgController.GetConfig(cfg.Id)
+		if modeCfg == nil || modeCfg.Schedule == nil {
+			modes = append(modes, &matchmakerpb.ScheduledGameMode{GameModeId: cfg.Id, Open: true})
+			continue
+		}
+
+		intervals := modeCfg.Schedule.Intervals(now, until)
+		if len(intervals) == 0 {
+			continue
+		}
+
+		mode := &matchmakerpb.ScheduledGameMode{GameModeId: cfg.Id, Scheduled: true}
+		if !intervals[0].Start.After(now) {
+			mode.Open = true
+			if intervals[0].End.Before(until) {
+				mode.ClosesAt = timestamppb.New(intervals[0].End)
+			}
+			intervals = intervals[1:]
+		}
+
+		for _, interval := range intervals {
+			mode.Upcoming = append(mode.Upcoming, &matchmakerpb.OpenWindow{
+				OpenTime:  timestamppb.New(interval.Start),
+				CloseTime: timestamppb.New(interval.End),
+			})
+		}
+
+		modes = append(modes, mode)
+	}
+
+	return &matchmakerpb.GetGameModeScheduleResponse{GameModes: modes}, nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/emortalmc/live-config-parser/golang/pkg/liveconfig"
+	"github.com/emortalmc/mono-services/services/matchmaker/gen/go/matchmakerpb"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/kafka"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/repository/model"
+	"github.com/emortalmc/mono-services/services/matchmaker/internal/trigger"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type spectatorService struct {
+	matchmakerpb.UnimplementedSpectatorServer
+
+	logger        *zap.SugaredLogger
+	repo          repository.Repository
+	notifier      kafka.Notifier
+	cfgController liveconfig.GameModeConfigController
+
+	directorTrigger trigger.Trigger
+}
+
+func newSpectatorService(logger *zap.SugaredLogger, repo repository.Repository, notifier kafka.Notifier,
+	cfgController liveconfig.GameModeConfigController, directorTrigger trigger.Trigger) matchmakerpb.SpectatorServer {
+
+	return &spectatorService{
+		logger:        logger,
+		repo:          repo,
+		notifier:      notifier,
+		cfgController: cfgController,
+
+		directorTrigger: directorTrigger,
+	}
+}
+
+var (
+	spectateAlreadyInQueueErr = panicIfErr(status.New(codes.AlreadyExists, "player is already in queue").
+					WithDetails(&matchmakerpb.QueueSpectatorErrorResponse{Reason: matchmakerpb.QueueSpectatorErrorResponse_ALREADY_IN_QUEUE})).Err()
+
+	spectateTargetNotInGameErr = panicIfErr(status.New(codes.NotFound, "target player is not in a game").
+					WithDetails(&matchmakerpb.QueueSpectatorErrorResponse{Reason: matchmakerpb.QueueSpectatorErrorResponse_TARGET_NOT_IN_GAME})).Err()
+
+	spectateMatchNotFoundErr = panicIfErr(status.New(codes.NotFound, "match not found").
+					WithDetails(&matchmakerpb.QueueSpectatorErrorResponse{Reason: matchmakerpb.QueueSpectatorErrorResponse_MATCH_NOT_FOUND})).Err()
+
+	spectateNotSpectatableErr = panicIfErr(status.New(codes.FailedPrecondition, "game mode can't be spectated").
+					WithDetails(&matchmakerpb.QueueSpectatorErrorResponse{Reason: matchmakerpb.QueueSpectatorErrorResponse_NOT_SPECTATABLE})).Err()
+)
+
+func (s *spectatorService) QueueSpectatorByPlayer(ctx context.Context, request *matchmakerpb.QueueSpectatorByPlayerRequest) (*matchmakerpb.QueueSpectatorByPlayerResponse, error) {
+	playerId, err := uuid.Parse(request.PlayerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid player_id")
+	}
+
+	var record *model.MatchRecord
+	switch target := request.Target.(type) {
+	case *matchmakerpb.QueueSpectatorByPlayerRequest_TargetPlayerId:
+		targetId, err := uuid.Parse(target.TargetPlayerId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid target_player_id")
+		}
+
+		record, err = s.repo.GetLatestMatchRecordByPlayerId(ctx, targetId)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, spectateTargetNotInGameErr
+			}
+			return nil, err
+		}
+	case *matchmakerpb.QueueSpectatorByPlayerRequest_MatchId:
+		record, err = s.repo.GetMatchRecordByMatchId(ctx, target.MatchId)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, spectateMatchNotFoundErr
+			}
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "target is required")
+	}
+
+	// Only game based servers track their matches, so we can't find the server of any other match
+	modeConfig := s.cfgController.GetCurrentConfig(record.GameModeId)
+	if modeConfig == nil || modeConfig.MatchmakerInfo.SelectMethod != liveconfig.SelectMethodAvailable {
+		return nil, spectateNotSpectatableErr
+	}
+
+	if _, err := s.repo.GetTicketByPlayerId(ctx, playerId); err == nil {
+		return nil, spectateAlreadyInQueueErr
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	ticket := model.NewSpectatorTicket(playerId, record.GameModeId, record.MatchId)
+
+	err = s.repo.ExecuteTransaction(ctx, func(ctx mongo.SessionContext) error {
+		if err := s.repo.CreateTicket(ctx, ticket); err != nil {
+			return err
+		}
+
+		if err := s.repo.CreateQueuedPlayers(ctx, []*model.QueuedPlayer{{PlayerId: playerId, TicketId: ticket.Id}}); err != nil {
+			return err
+		}
+
+		return s.notifier.TicketCreated(ctx, ticket)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.directorTrigger.Notify(record.GameModeId)
+
+	return &matchmakerpb.QueueSpectatorByPlayerResponse{
+		MatchId:    record.MatchId,
+		GameModeId: record.GameModeId,
+	}, nil
+}
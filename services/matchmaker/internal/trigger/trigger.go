@@ -0,0 +1,65 @@
+package trigger
+
+import "sync"
+
+// Trigger wakes the director loop of a game mode when its tickets change, so it doesn't have to poll Mongo.
+// The Trigger returned by New only wakes loops of this replica, see kafka.NewDistributedTrigger.
+type Trigger interface {
+	// Notify wakes the loop of a game mode. Never blocks, notifications are merged until the loop wakes.
+	Notify(gameModeId string)
+
+	// NotifyAll wakes every loop. Used when the game mode of the changed ticket isn't known.
+	NotifyAll()
+
+	// Subscribe returns the channel the loop of a game mode waits on.
+	Subscribe(gameModeId string) <-chan struct{}
+}
+
+type triggerImpl struct {
+	channels     map[string]chan struct{}
+	channelsLock sync.Mutex
+}
+
+func New() Trigger {
+	return &triggerImpl{
+		channels: make(map[string]chan struct{}),
+	}
+}
+
+func (t *triggerImpl) Notify(gameModeId string) {
+	wake(t.getChannel(gameModeId))
+}
+
+func (t *triggerImpl) NotifyAll() {
+	t.channelsLock.Lock()
+	defer t.channelsLock.Unlock()
+
+	for _, channel := range t.channels {
+		wake(channel)
+	}
+}
+
+func (t *triggerImpl) Subscribe(gameModeId string) <-chan struct{} {
+	return t.getChannel(gameModeId)
+}
+
+func (t *triggerImpl) getChannel(gameModeId string) chan struct{} {
+	t.channelsLock.Lock()
+	defer t.channelsLock.Unlock()
+
+	channel, ok := t.channels[gameModeId]
+	if !ok {
+		// Buffer of 1 so a notification sent during a run isn't lost
+		channel = make(chan struct{}, 1)
+		t.channels[gameModeId] = channel
+	}
+
+	return channel
+}
+
+func wake(channel chan struct{}) {
+	select {
+	case channel <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,14 @@
+package experience
+
+import "math"
+
+// Must be kept in sync with the mc-player-service.
+const (
+	a = 0.15
+	b = float64(2)
+)
+
+func XPToLevel(xp int) int {
+	level := a * math.Pow(float64(xp), 1/b)
+	return int(level)
+}